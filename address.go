@@ -0,0 +1,76 @@
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+
+	"github.com/mr-tron/base58"
+	"github.com/nspcc-dev/neofs-crypto/internal"
+	"golang.org/x/crypto/ripemd160" //nolint:staticcheck // used by Neo for script hashes
+)
+
+const (
+	// ScriptHashSize is a size of Neo script hash (RIPEMD160 of SHA256).
+	ScriptHashSize = 20
+
+	// AddressVersion is a version byte of Neo N3 address.
+	AddressVersion = 0x35
+
+	// VerificationScriptSize is a size of single-signature verification script.
+	VerificationScriptSize = 40
+
+	opPushData1 = 0x0c
+	opSyscall   = 0x41
+)
+
+// checkSigInteropID is an interop ID of System.Crypto.CheckSig syscall.
+var checkSigInteropID = [4]byte{0x56, 0xe7, 0xb3, 0x27}
+
+// VerificationScript returns Neo single-signature verification script
+// for the public key: PUSHDATA1 33 <compressed key> SYSCALL System.Crypto.CheckSig.
+func VerificationScript(pub *ecdsa.PublicKey) []byte {
+	key := MarshalPublicKey(pub)
+	if key == nil {
+		return nil
+	}
+
+	script := make([]byte, 0, VerificationScriptSize)
+	script = append(script, opPushData1, PublicKeyCompressedSize)
+	script = append(script, key...)
+	script = append(script, opSyscall)
+	script = append(script, checkSigInteropID[:]...)
+
+	return script
+}
+
+// ScriptHash returns RIPEMD160(SHA256(script)) in big-endian byte order.
+func ScriptHash(script []byte) [ScriptHashSize]byte {
+	var res [ScriptHashSize]byte
+
+	sum := sha256.Sum256(script)
+	h := ripemd160.New()
+	_, _ = h.Write(sum[:])
+	copy(res[:], h.Sum(nil))
+
+	return res
+}
+
+// PublicKeyScriptHash returns script hash of the public key verification script.
+func PublicKeyScriptHash(pub *ecdsa.PublicKey) [ScriptHashSize]byte {
+	return ScriptHash(VerificationScript(pub))
+}
+
+// ScriptHashToAddress encodes script hash into Neo N3 address.
+func ScriptHashToAddress(hash [ScriptHashSize]byte) string {
+	data := make([]byte, 0, 1+ScriptHashSize+4)
+	data = append(data, AddressVersion)
+	data = append(data, hash[:]...)
+	data = append(data, internal.Checksum(data)...)
+
+	return base58.Encode(data)
+}
+
+// PublicKeyAddress returns Neo N3 address of the public key.
+func PublicKeyAddress(pub *ecdsa.PublicKey) string {
+	return ScriptHashToAddress(PublicKeyScriptHash(pub))
+}
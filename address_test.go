@@ -0,0 +1,26 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublicKeyAddress(t *testing.T) {
+	data, err := hex.DecodeString("02b3622bf4017bdfe317c58aed5f4c753f206b7db896046fa7d774bbc4bf7f8dc2")
+	require.NoError(t, err)
+
+	pub := UnmarshalPublicKey(data)
+	require.NotNil(t, pub)
+
+	script := VerificationScript(pub)
+	require.Len(t, script, VerificationScriptSize)
+	require.Equal(t, "0c21"+hex.EncodeToString(data)+"4156e7b327", hex.EncodeToString(script))
+
+	hash := PublicKeyScriptHash(pub)
+	require.Equal(t, "ee9ea22c27e34bd0148fc4108e08f74e8f5048b2", hex.EncodeToString(hash[:]))
+
+	require.Equal(t, "Nhfg3TbpwogLvDGVvAvqyThbsHgoSUKwtn", PublicKeyAddress(pub))
+	require.Nil(t, VerificationScript(nil))
+}
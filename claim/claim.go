@@ -0,0 +1,173 @@
+/*
+Package claim implements time-bounded signed claims. Claim binds payload
+hash to validity window and is signed by crypto.SignRFC6979WithDomain, so
+the signature can't be reused after expiration or as a signature of any
+other structure.
+
+Claim format (version 1):
+
+	version (1 byte)
+	issued at (8 bytes, big-endian Unix seconds)
+	not before (8 bytes, big-endian Unix seconds)
+	expires at (8 bytes, big-endian Unix seconds)
+	payload hash (32 bytes, SHA-256)
+	signature (64 bytes)
+
+All fields except the signature are signed.
+*/
+package claim
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/internal"
+)
+
+const (
+	// Version is the current version of claim format.
+	Version = 1
+
+	// Size is the size of encoded claim.
+	Size = signedSize + crypto.RFC6979SignatureSize
+
+	signedSize = 1 + 3*internal.UnixSize + sha256.Size
+
+	// ErrBadWindow when claim validity window is empty.
+	ErrBadWindow = internal.Error("bad validity window")
+
+	// ErrBadClaim when claim can't be decoded.
+	ErrBadClaim = internal.Error("bad claim")
+
+	// ErrUnsupportedVersion when claim has unknown version.
+	ErrUnsupportedVersion = internal.Error("unsupported claim version")
+
+	// ErrBadSignature when claim signature is invalid.
+	ErrBadSignature = internal.Error("bad claim signature")
+
+	// ErrPayloadMismatch when claim is signed for another payload.
+	ErrPayloadMismatch = internal.Error("claim payload mismatch")
+
+	// ErrNotYetValid when current time is before claim NotBefore.
+	ErrNotYetValid = internal.Error("claim is not yet valid")
+
+	// ErrExpired when current time is not before claim ExpiresAt.
+	ErrExpired = internal.Error("claim is expired")
+)
+
+var domain = crypto.MustRegisterDomain("neofs-crypto/claim/v1")
+
+// Claim is a signed statement about payload valid in [NotBefore, ExpiresAt)
+// time range. Times have second precision.
+type Claim struct {
+	IssuedAt    time.Time
+	NotBefore   time.Time
+	ExpiresAt   time.Time
+	PayloadHash [sha256.Size]byte
+	Signature   []byte
+}
+
+// Sign creates claim about payload issued at clock.Now() and valid in
+// [notBefore, expiresAt) range. Zero notBefore means valid since issuing,
+// nil clock means crypto.SystemClock.
+func Sign(key *ecdsa.PrivateKey, payload []byte, notBefore, expiresAt time.Time, clock crypto.Clock) (*Claim, error) {
+	if key == nil {
+		return nil, crypto.ErrEmptyPrivateKey
+	}
+
+	c := &Claim{
+		IssuedAt:    internal.TruncateTime(internal.Now(clock)),
+		NotBefore:   internal.TruncateTime(notBefore),
+		ExpiresAt:   internal.TruncateTime(expiresAt),
+		PayloadHash: sha256.Sum256(payload),
+	}
+
+	if notBefore.IsZero() {
+		c.NotBefore = c.IssuedAt
+	}
+
+	if !c.ExpiresAt.After(c.NotBefore) {
+		return nil, fmt.Errorf("%w: not before %s, expires at %s", ErrBadWindow, c.NotBefore, c.ExpiresAt)
+	}
+
+	var err error
+	if c.Signature, err = crypto.SignRFC6979WithDomain(key, domain, c.signedData()); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Verifier checks claims against the clock.
+type Verifier struct {
+	clock crypto.Clock
+}
+
+// NewVerifier returns verifier using the given clock, nil clock means
+// crypto.SystemClock.
+func NewVerifier(clock crypto.Clock) *Verifier {
+	return &Verifier{clock: clock}
+}
+
+// Verify checks that claim is signed by pub for the payload and is valid at
+// the current time. Signature is checked first, so ErrNotYetValid and
+// ErrExpired are returned for authentic claims only.
+func (v *Verifier) Verify(pub *ecdsa.PublicKey, c *Claim, payload []byte) error {
+	if err := crypto.VerifyRFC6979WithDomain(pub, domain, c.signedData(), c.Signature); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadSignature, err)
+	} else if sha256.Sum256(payload) != c.PayloadHash {
+		return ErrPayloadMismatch
+	}
+
+	now := internal.Now(v.clock)
+
+	if now.Before(c.NotBefore) {
+		return fmt.Errorf("%w: now %s, not before %s", ErrNotYetValid, now.UTC(), c.NotBefore)
+	} else if !now.Before(c.ExpiresAt) {
+		return fmt.Errorf("%w: now %s, expires at %s", ErrExpired, now.UTC(), c.ExpiresAt)
+	}
+
+	return nil
+}
+
+func (c *Claim) signedData() []byte {
+	buf := make([]byte, 0, signedSize)
+	buf = append(buf, Version)
+	buf = internal.AppendUnix(buf, c.IssuedAt)
+	buf = internal.AppendUnix(buf, c.NotBefore)
+	buf = internal.AppendUnix(buf, c.ExpiresAt)
+
+	return append(buf, c.PayloadHash[:]...)
+}
+
+// Bytes returns binary form of the claim.
+func (c *Claim) Bytes() []byte {
+	return append(c.signedData(), c.Signature...)
+}
+
+// Decode parses binary form of the claim.
+func Decode(data []byte) (*Claim, error) {
+	if ln := len(data); ln != Size {
+		return nil, fmt.Errorf("%w: actual=%d, expect=%d", ErrBadClaim, ln, Size)
+	} else if data[0] != Version {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, data[0])
+	}
+
+	c := &Claim{
+		IssuedAt:  internal.DecodeUnix(data[1:]),
+		NotBefore: internal.DecodeUnix(data[9:]),
+		ExpiresAt: internal.DecodeUnix(data[17:]),
+		Signature: append([]byte(nil), data[signedSize:]...),
+	}
+
+	copy(c.PayloadHash[:], data[25:signedSize])
+
+	if !c.ExpiresAt.After(c.NotBefore) {
+		return nil, fmt.Errorf("%w: %w", ErrBadClaim, ErrBadWindow)
+	}
+
+	return c, nil
+}
@@ -0,0 +1,109 @@
+package claim
+
+import (
+	"testing"
+	"time"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/stretchr/testify/require"
+)
+
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+func TestClaim(t *testing.T) {
+	key := test.DecodeKey(1)
+	payload := []byte("session token body")
+
+	issued := time.Date(2026, 10, 18, 12, 0, 0, 500, time.UTC)
+	notBefore := issued.Add(time.Minute)
+	expires := issued.Add(time.Hour)
+
+	c, err := Sign(key, payload, notBefore, expires, fixedClock(issued))
+	require.NoError(t, err)
+	require.Equal(t, issued.Truncate(time.Second), c.IssuedAt)
+	require.Equal(t, notBefore.Truncate(time.Second), c.NotBefore)
+	require.Equal(t, expires.Truncate(time.Second), c.ExpiresAt)
+
+	verify := func(now time.Time, c *Claim, payload []byte) error {
+		return NewVerifier(fixedClock(now)).Verify(&key.PublicKey, c, payload)
+	}
+
+	require.NoError(t, verify(notBefore, c, payload))
+	require.NoError(t, verify(expires.Add(-time.Second), c, payload))
+
+	t.Run("window", func(t *testing.T) {
+		require.ErrorIs(t, verify(issued, c, payload), ErrNotYetValid)
+		require.ErrorIs(t, verify(notBefore.Add(-time.Second), c, payload), ErrNotYetValid)
+		require.ErrorIs(t, verify(expires, c, payload), ErrExpired)
+		require.ErrorIs(t, verify(expires.Add(24*time.Hour), c, payload), ErrExpired)
+	})
+
+	t.Run("encoding", func(t *testing.T) {
+		data := c.Bytes()
+		require.Len(t, data, Size)
+
+		decoded, err := Decode(data)
+		require.NoError(t, err)
+		require.Equal(t, c, decoded)
+		require.NoError(t, verify(notBefore, decoded, payload))
+
+		_, err = Decode(data[1:])
+		require.ErrorIs(t, err, ErrBadClaim)
+
+		data[0]++
+		_, err = Decode(data)
+		require.ErrorIs(t, err, ErrUnsupportedVersion)
+	})
+
+	t.Run("tampering", func(t *testing.T) {
+		require.ErrorIs(t, verify(notBefore, c, []byte("other body")), ErrPayloadMismatch)
+
+		extended := *c
+		extended.ExpiresAt = extended.ExpiresAt.Add(time.Hour)
+		err := verify(expires, &extended, payload)
+		require.ErrorIs(t, err, ErrBadSignature)
+		require.ErrorIs(t, err, crypto.ErrWrongSignature)
+
+		err = NewVerifier(fixedClock(notBefore)).Verify(&test.DecodeKey(2).PublicKey, c, payload)
+		require.ErrorIs(t, err, ErrBadSignature)
+
+		plain, err := crypto.SignRFC6979(key, c.signedData())
+		require.NoError(t, err)
+
+		undomained := *c
+		undomained.Signature = plain
+		require.ErrorIs(t, verify(notBefore, &undomained, payload), ErrBadSignature)
+	})
+
+	t.Run("sign", func(t *testing.T) {
+		c, err := Sign(key, payload, time.Time{}, expires, fixedClock(issued))
+		require.NoError(t, err)
+		require.Equal(t, c.IssuedAt, c.NotBefore)
+		require.NoError(t, verify(issued, c, payload))
+
+		_, err = Sign(key, payload, expires, expires, fixedClock(issued))
+		require.ErrorIs(t, err, ErrBadWindow)
+
+		_, err = Sign(key, payload, time.Time{}, time.Time{}, fixedClock(issued))
+		require.ErrorIs(t, err, ErrBadWindow)
+
+		_, err = Sign(nil, payload, notBefore, expires, fixedClock(issued))
+		require.ErrorIs(t, err, crypto.ErrEmptyPrivateKey)
+	})
+
+	t.Run("system clock", func(t *testing.T) {
+		c, err := Sign(key, payload, time.Time{}, time.Now().Add(time.Hour), crypto.SystemClock)
+		require.NoError(t, err)
+		require.NoError(t, NewVerifier(crypto.SystemClock).Verify(&key.PublicKey, c, payload))
+
+		c, err = Sign(key, payload, time.Time{}, time.Now().Add(time.Hour), nil)
+		require.NoError(t, err)
+		require.NoError(t, NewVerifier(nil).Verify(&key.PublicKey, c, payload))
+		require.NoError(t, new(Verifier).Verify(&key.PublicKey, c, payload))
+	})
+}
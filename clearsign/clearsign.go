@@ -0,0 +1,241 @@
+/*
+Package clearsign implements human-readable signed messages. Message text
+stays readable and is wrapped together with signer public key and
+RFC6979 signature into an armor that survives copying through chats and
+ticket trackers:
+
+	-----BEGIN NEOFS SIGNED MESSAGE-----
+	Public-Key: <compressed public key, hex>
+	Signature: <RFC6979 signature, base64>
+
+	<message text>
+	-----END NEOFS SIGNED MESSAGE-----
+
+Message is normalized (see Normalize) and signed by
+crypto.SignRFC6979WithDomain, so changed line endings, trailing spaces and
+surrounding blank lines do not invalidate the signature. Message lines starting with '-' (possibly after spaces or
+tabs) are escaped by "- " prefix, unescaped ones are rejected by Parse.
+*/
+package clearsign
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/internal"
+)
+
+const (
+	// BeginMarker starts armored signed message.
+	BeginMarker = "-----BEGIN NEOFS SIGNED MESSAGE-----"
+
+	// EndMarker ends armored signed message.
+	EndMarker = "-----END NEOFS SIGNED MESSAGE-----"
+
+	headerPublicKey = "Public-Key"
+	headerSignature = "Signature"
+	dashEscape      = "- "
+
+	// ErrNoArmor when data contains no signed message.
+	ErrNoArmor = internal.Error("no signed message found")
+
+	// ErrBadArmor when signed message can't be parsed.
+	ErrBadArmor = internal.Error("bad signed message")
+)
+
+var domain = crypto.MustRegisterDomain("neofs-crypto/clearsign/v1")
+
+// Message is a clear-signed message.
+type Message struct {
+	// Text is the normalized message text.
+	Text      []byte
+	PublicKey *ecdsa.PublicKey
+	Signature []byte
+}
+
+// Normalize returns canonical form of the message: line endings are
+// converted to LF, trailing spaces and tabs are removed from every line,
+// leading and trailing blank lines are dropped. Result has no trailing LF.
+func Normalize(msg []byte) []byte {
+	lines := splitLines(msg)
+	for i := range lines {
+		lines[i] = bytes.TrimRight(lines[i], " \t")
+	}
+
+	for len(lines) > 0 && len(lines[0]) == 0 {
+		lines = lines[1:]
+	}
+
+	for len(lines) > 0 && len(lines[len(lines)-1]) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+
+	return bytes.Join(lines, []byte{'\n'})
+}
+
+// Sign normalizes and signs the message, then returns its armored form.
+func Sign(key *ecdsa.PrivateKey, msg []byte) ([]byte, error) {
+	if key == nil {
+		return nil, crypto.ErrEmptyPrivateKey
+	}
+
+	m := &Message{
+		Text:      Normalize(msg),
+		PublicKey: &ecdsa.PublicKey{Curve: key.Curve, X: key.X, Y: key.Y},
+	}
+
+	var err error
+	if m.Signature, err = crypto.SignRFC6979WithDomain(key, domain, m.Text); err != nil {
+		return nil, err
+	}
+
+	return m.Armor(), nil
+}
+
+// Verify checks signature by the embedded public key. Caller must check
+// that PublicKey belongs to the expected signer.
+func (m *Message) Verify() error {
+	return crypto.VerifyRFC6979WithDomain(m.PublicKey, domain, Normalize(m.Text), m.Signature)
+}
+
+// Armor returns armored form of the message.
+func (m *Message) Armor() []byte {
+	var b bytes.Buffer
+
+	b.WriteString(BeginMarker + "\n")
+	b.WriteString(headerPublicKey + ": " + hex.EncodeToString(crypto.MarshalPublicKey(m.PublicKey)) + "\n")
+	b.WriteString(headerSignature + ": " + base64.StdEncoding.EncodeToString(m.Signature) + "\n")
+	b.WriteString("\n")
+
+	if text := Normalize(m.Text); len(text) != 0 {
+		for _, line := range bytes.Split(text, []byte{'\n'}) {
+			if needsEscape(line) {
+				b.WriteString(dashEscape)
+			}
+
+			b.Write(line)
+			b.WriteByte('\n')
+		}
+	}
+
+	b.WriteString(EndMarker + "\n")
+
+	return b.Bytes()
+}
+
+// Parse finds the first armored message in data and decodes it. Text
+// around the armor is ignored. Signature is not checked, see Verify.
+func Parse(data []byte) (*Message, error) {
+	lines := splitLines(data)
+
+	i := 0
+	for ; i < len(lines) && string(bytes.TrimSpace(lines[i])) != BeginMarker; i++ {
+	}
+
+	if i == len(lines) {
+		return nil, ErrNoArmor
+	}
+
+	m := new(Message)
+
+	for i++; i < len(lines); i++ {
+		line := bytes.TrimSpace(lines[i])
+		if len(line) == 0 {
+			break
+		}
+
+		if err := m.setHeader(string(line)); err != nil {
+			return nil, err
+		}
+	}
+
+	if m.PublicKey == nil {
+		return nil, fmt.Errorf("%w: missing %s header", ErrBadArmor, headerPublicKey)
+	} else if m.Signature == nil {
+		return nil, fmt.Errorf("%w: missing %s header", ErrBadArmor, headerSignature)
+	}
+
+	var text [][]byte
+
+	for i++; i < len(lines); i++ {
+		line := lines[i]
+		if string(bytes.TrimSpace(line)) == EndMarker {
+			m.Text = Normalize(bytes.Join(text, []byte{'\n'}))
+			return m, nil
+		}
+
+		// whitespace before escape can only be added in transit
+		if escaped := bytes.TrimLeft(line, " \t"); bytes.HasPrefix(escaped, []byte(dashEscape)) {
+			line = escaped[len(dashEscape):]
+		} else if needsEscape(line) {
+			return nil, fmt.Errorf("%w: unescaped dash line %q", ErrBadArmor, line)
+		}
+
+		text = append(text, line)
+	}
+
+	return nil, fmt.Errorf("%w: missing end marker", ErrBadArmor)
+}
+
+// Verify parses the first armored message in data and checks its
+// signature. Caller must check that PublicKey of the result belongs to
+// the expected signer.
+func Verify(data []byte) (*Message, error) {
+	m, err := Parse(data)
+	if err != nil {
+		return nil, err
+	} else if err = m.Verify(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func (m *Message) setHeader(line string) error {
+	name, value, ok := strings.Cut(line, ":")
+	if !ok {
+		return fmt.Errorf("%w: bad header %q", ErrBadArmor, line)
+	}
+
+	name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+
+	switch {
+	case strings.EqualFold(name, headerPublicKey) && m.PublicKey == nil:
+		data, err := hex.DecodeString(value)
+		if err != nil {
+			return fmt.Errorf("%w: bad public key: %w", ErrBadArmor, err)
+		} else if m.PublicKey = crypto.UnmarshalPublicKey(data); m.PublicKey == nil {
+			return fmt.Errorf("%w: bad public key", ErrBadArmor)
+		}
+	case strings.EqualFold(name, headerSignature) && m.Signature == nil:
+		data, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return fmt.Errorf("%w: bad signature: %w", ErrBadArmor, err)
+		}
+
+		m.Signature = data
+	default:
+		return fmt.Errorf("%w: unexpected header %q", ErrBadArmor, name)
+	}
+
+	return nil
+}
+
+// needsEscape returns true if line may be confused with armor marker,
+// i.e. starts with '-' after leading whitespace.
+func needsEscape(line []byte) bool {
+	return bytes.HasPrefix(bytes.TrimLeft(line, " \t"), []byte{'-'})
+}
+
+// splitLines splits data into lines accepting LF, CRLF and CR endings.
+func splitLines(data []byte) [][]byte {
+	data = bytes.ReplaceAll(data, []byte("\r\n"), []byte{'\n'})
+	data = bytes.ReplaceAll(data, []byte{'\r'}, []byte{'\n'})
+
+	return bytes.Split(data, []byte{'\n'})
+}
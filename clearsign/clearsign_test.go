@@ -0,0 +1,119 @@
+package clearsign
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	require.Equal(t, "a\n\n b", string(Normalize([]byte("\r\n  \na \t\r\n\r b  \n\n"))))
+	require.Empty(t, Normalize([]byte(" \n\t\n")))
+}
+
+func TestSignVerify(t *testing.T) {
+	key := test.DecodeKey(1)
+	msg := []byte("{\n  \"container\": \"5HbPS3m8XN5bKy2nqPbTM6sKDrxHTuVjz8bfN1vwZbwr\",\n" +
+		"  \"verb\": \"PUT\"\n}\n---\n-----END NEOFS SIGNED MESSAGE-----\n")
+
+	armored, err := Sign(key, msg)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(armored), BeginMarker+"\nPublic-Key: "+
+		hex.EncodeToString(crypto.MarshalPublicKey(&key.PublicKey))+"\n"))
+	require.True(t, strings.HasSuffix(string(armored), "\n"+EndMarker+"\n"))
+	require.Contains(t, string(armored), "\n- ---\n- -----END NEOFS SIGNED MESSAGE-----\n")
+
+	m, err := Verify(armored)
+	require.NoError(t, err)
+	require.Equal(t, Normalize(msg), m.Text)
+	require.Equal(t, crypto.MarshalPublicKey(&key.PublicKey), crypto.MarshalPublicKey(m.PublicKey))
+	require.Equal(t, armored, m.Armor())
+
+	t.Run("whitespace normalization", func(t *testing.T) {
+		mangled := strings.ReplaceAll(string(armored), "\n", "  \r\n")
+		mangled = "Here is the request:\r\n\r\n  " + mangled + "\r\nThanks!"
+
+		m, err := Verify([]byte(mangled))
+		require.NoError(t, err)
+		require.Equal(t, Normalize(msg), m.Text)
+	})
+
+	t.Run("tampering", func(t *testing.T) {
+		tampered := bytes.Replace(armored, []byte("PUT"), []byte("DELETE"), 1)
+
+		_, err := Verify(tampered)
+		require.ErrorIs(t, err, crypto.ErrWrongSignature)
+
+		m, err := Parse(tampered)
+		require.NoError(t, err)
+
+		other := test.DecodeKey(2)
+		m.PublicKey = &other.PublicKey
+		m.Text = Normalize(msg)
+		require.ErrorIs(t, m.Verify(), crypto.ErrWrongSignature)
+
+		m.PublicKey = &key.PublicKey
+		m.Signature, err = crypto.SignRFC6979(key, m.Text)
+		require.NoError(t, err)
+		require.ErrorIs(t, m.Verify(), crypto.ErrWrongSignature)
+	})
+
+	t.Run("indented dash lines", func(t *testing.T) {
+		msg := []byte("a\n  -----END NEOFS SIGNED MESSAGE-----\n\t- item\nb")
+
+		armored, err := Sign(key, msg)
+		require.NoError(t, err)
+		require.Contains(t, string(armored), "\n-   -----END NEOFS SIGNED MESSAGE-----\n- \t- item\n")
+
+		m, err := Verify(armored)
+		require.NoError(t, err)
+		require.Equal(t, msg, m.Text)
+
+		indented := strings.ReplaceAll(string(armored), "\n- ", "\n  - ")
+		m, err = Verify([]byte(indented))
+		require.NoError(t, err)
+		require.Equal(t, msg, m.Text)
+	})
+
+	t.Run("empty message", func(t *testing.T) {
+		armored, err := Sign(key, nil)
+		require.NoError(t, err)
+
+		m, err := Verify(armored)
+		require.NoError(t, err)
+		require.Empty(t, m.Text)
+	})
+
+	t.Run("bad input", func(t *testing.T) {
+		_, err := Sign(nil, msg)
+		require.ErrorIs(t, err, crypto.ErrEmptyPrivateKey)
+
+		_, err = Parse(msg)
+		require.ErrorIs(t, err, ErrNoArmor)
+
+		lines := strings.Split(string(armored), "\n")
+
+		for _, tc := range []struct {
+			name string
+			data string
+		}{
+			{"no end marker", strings.Join(lines[:len(lines)-2], "\n")},
+			{"no public key", strings.Join(append(lines[:1:1], lines[2:]...), "\n")},
+			{"no signature", strings.Join(append(lines[:2:2], lines[3:]...), "\n")},
+			{"unknown header", strings.Replace(string(armored), "\n\n", "\nVersion: 2\n\n", 1)},
+			{"duplicate header", strings.Replace(string(armored), "\n\n", "\n"+lines[1]+"\n\n", 1)},
+			{"bad public key", strings.Replace(string(armored), lines[1], "Public-Key: 0011", 1)},
+			{"bad signature", strings.Replace(string(armored), lines[2], "Signature: !", 1)},
+			{"bad header", strings.Replace(string(armored), lines[2], "Signature", 1)},
+			{"unescaped dash", strings.Replace(string(armored), "\n- ---\n", "\n  ---\n", 1)},
+		} {
+			_, err := Parse([]byte(tc.data))
+			require.ErrorIs(t, err, ErrBadArmor, tc.name)
+		}
+	})
+}
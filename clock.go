@@ -0,0 +1,19 @@
+package crypto
+
+import "time"
+
+// Clock provides current time to verifiers of time-bounded signatures.
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc is an adapter to use function as Clock.
+type ClockFunc func() time.Time
+
+// SystemClock is a Clock returning local system time.
+var SystemClock Clock = ClockFunc(time.Now)
+
+// Now returns f().
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	crypto "github.com/nspcc-dev/neofs-crypto"
+)
+
+func runConvert(e *env, args []string) error {
+	fs := newFlagSet(e, "convert", "<key>")
+	to := fs.String("to", "wif", "output format: "+formatNames)
+	out := fs.String("out", "", "write key into file (0600, refuses to overwrite) instead of stdout")
+	force := fs.Bool("force", false, "overwrite existing file")
+
+	rest, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	key, err := loadKey(e, rest)
+	if err != nil {
+		return err
+	}
+
+	defer crypto.DestroyPrivateKey(key)
+
+	return writeKey(e, key, *to, *out, *force)
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+)
+
+func runGen(e *env, args []string) error {
+	fs := newFlagSet(e, "gen", "")
+	format := fs.String("format", "wif", "output format: "+formatNames)
+	out := fs.String("out", "", "write key into file (0600, refuses to overwrite) instead of stdout")
+	force := fs.Bool("force", false, "overwrite existing file")
+	vanity := fs.String("vanity", "", "search for a key whose Neo address starts with the given prefix")
+
+	rest, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	} else if len(rest) != 0 {
+		return fmt.Errorf("%w: unexpected arguments %v", errUsage, rest)
+	}
+
+	var key *ecdsa.PrivateKey
+
+	if *vanity != "" {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+
+		key, err = crypto.GenerateVanityKey(ctx, *vanity)
+	} else {
+		key, err = crypto.GenerateKey()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	defer crypto.DestroyPrivateKey(key)
+
+	return writeKey(e, key, *format, *out, *force)
+}
+
+// writeKey writes key in the given format into file or stdout.
+func writeKey(e *env, key *ecdsa.PrivateKey, format, out string, force bool) error {
+	if out != "" {
+		f, ok := formats[strings.ToLower(format)]
+		if !ok {
+			return fmt.Errorf("%w: unknown format %q, expect %s", errUsage, format, formatNames)
+		} else if force {
+			return crypto.OverwritePrivateKey(out, key, f)
+		}
+
+		return crypto.SavePrivateKey(out, key, f)
+	}
+
+	data, err := encodeKey(key, format)
+	if err != nil {
+		return err
+	}
+
+	if isText(format) && (len(data) == 0 || data[len(data)-1] != '\n') {
+		data = append(data, '\n')
+	}
+
+	_, err = e.stdout.Write(data)
+
+	return err
+}
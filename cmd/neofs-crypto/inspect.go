@@ -0,0 +1,51 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"fmt"
+	"text/tabwriter"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+)
+
+func runInspect(e *env, args []string) error {
+	fs := newFlagSet(e, "inspect", "<key>")
+
+	rest, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	key, err := loadKey(e, rest)
+	if err != nil {
+		return err
+	}
+
+	defer crypto.DestroyPrivateKey(key)
+
+	hash := crypto.PublicKeyScriptHash(&key.PublicKey)
+	le := make([]byte, len(hash))
+
+	for i := range hash {
+		le[len(hash)-1-i] = hash[i]
+	}
+
+	w := tabwriter.NewWriter(e.stdout, 0, 0, 1, ' ', 0)
+	fmt.Fprintf(w, "Public key:\t%s\n", hex.EncodeToString(crypto.MarshalPublicKey(&key.PublicKey)))
+	fmt.Fprintf(w, "Uncompressed:\t%s\n", hex.EncodeToString(marshalUncompressed(key)))
+	fmt.Fprintf(w, "Address:\t%s\n", crypto.PublicKeyAddress(&key.PublicKey))
+	fmt.Fprintf(w, "Script hash:\t0x%s\n", hex.EncodeToString(le))
+
+	return w.Flush()
+}
+
+// marshalUncompressed returns public key in 65-byte uncompressed form.
+func marshalUncompressed(key *ecdsa.PrivateKey) []byte {
+	buf := make([]byte, crypto.PublicKeyUncompressedSize)
+	buf[0] = 0x04
+	key.X.FillBytes(buf[1:33])
+	key.Y.FillBytes(buf[33:])
+
+	return buf
+}
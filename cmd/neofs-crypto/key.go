@@ -0,0 +1,63 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"io"
+	"strings"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/internal"
+)
+
+// formats maps format names accepted by commands to key formats.
+var formats = map[string]crypto.KeyFormat{
+	"raw":      crypto.FormatRaw,
+	"hex":      crypto.FormatHex,
+	"wif":      crypto.FormatWIF,
+	"der":      crypto.FormatDER,
+	"pem":      crypto.FormatPEM,
+	"mnemonic": crypto.FormatMnemonic,
+}
+
+const formatNames = "wif, hex, pem, der, raw or mnemonic"
+
+// loadKey reads private key from the single positional argument: WIF, hex,
+// mnemonic phrase or file path (see crypto.LoadPrivateKey). "-" reads key
+// in any key file format from stdin, so it doesn't appear in argv.
+func loadKey(e *env, args []string) (*ecdsa.PrivateKey, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("%w: expected exactly one key argument", errUsage)
+	} else if args[0] != "-" {
+		return crypto.LoadPrivateKey(args[0])
+	}
+
+	data, err := io.ReadAll(e.stdin)
+	defer internal.WipeBytes(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.DecodePrivateKey(data)
+}
+
+// encodeKey encodes private key into the named format.
+func encodeKey(key *ecdsa.PrivateKey, format string) ([]byte, error) {
+	f, ok := formats[strings.ToLower(format)]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown format %q, expect %s", errUsage, format, formatNames)
+	}
+
+	return crypto.EncodePrivateKey(key, f)
+}
+
+// isText returns true if format is printable.
+func isText(format string) bool {
+	switch strings.ToLower(format) {
+	case "raw", "der":
+		return false
+	default:
+		return true
+	}
+}
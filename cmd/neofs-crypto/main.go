@@ -0,0 +1,156 @@
+// Command neofs-crypto generates, converts and inspects NeoFS keys.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+)
+
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+
+	// exitInvalidSignature is returned when signature doesn't match the
+	// message (crypto.ErrInvalidSignature or crypto.ErrWrongSignature).
+	exitInvalidSignature = 3
+
+	// exitCannotUnmarshal is returned for malformed signatures
+	// (crypto.ErrCannotUnmarshal).
+	exitCannotUnmarshal = 4
+
+	// exitWrongHashSize is returned for RFC6979 signatures of wrong size
+	// (crypto.ErrWrongHashSize).
+	exitWrongHashSize = 5
+)
+
+// env is the command execution environment.
+type env struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+type command struct {
+	usage string
+	run   func(e *env, args []string) error
+}
+
+var commands = map[string]command{
+	"gen":     {usage: "generate new private key", run: runGen},
+	"convert": {usage: "convert private key into another format", run: runConvert},
+	"pubkey":  {usage: "print public key of private key", run: runPubkey},
+	"inspect": {usage: "print public key, Neo address and script hash", run: runInspect},
+	"sign":    {usage: "sign file or stdin", run: runSign},
+	"verify":  {usage: "verify signature of file or stdin", run: runVerify},
+}
+
+// errUsage is returned by commands on wrong arguments.
+var errUsage = errors.New("wrong usage")
+
+func main() {
+	os.Exit(run(os.Args[1:], &env{stdin: os.Stdin, stdout: os.Stdout, stderr: os.Stderr}))
+}
+
+func run(args []string, e *env) int {
+	if len(args) == 0 || args[0] == "-h" || args[0] == "--help" || args[0] == "help" {
+		printUsage(e.stderr)
+		return exitUsage
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(e.stderr, "unknown command %q\n", args[0])
+		printUsage(e.stderr)
+
+		return exitUsage
+	}
+
+	if err := cmd.run(e, args[1:]); err != nil {
+		if errors.Is(err, errUsage) || errors.Is(err, flag.ErrHelp) {
+			if !errors.Is(err, flag.ErrHelp) {
+				fmt.Fprintln(e.stderr, err)
+			}
+
+			return exitUsage
+		}
+
+		fmt.Fprintln(e.stderr, "error:", err)
+
+		return exitCode(err)
+	}
+
+	return exitOK
+}
+
+// exitCode returns process exit code for command error.
+func exitCode(err error) int {
+	switch {
+	case errors.Is(err, crypto.ErrInvalidSignature), errors.Is(err, crypto.ErrWrongSignature):
+		return exitInvalidSignature
+	case errors.Is(err, crypto.ErrCannotUnmarshal):
+		return exitCannotUnmarshal
+	case errors.Is(err, crypto.ErrWrongHashSize):
+		return exitWrongHashSize
+	default:
+		return exitError
+	}
+}
+
+func printUsage(w io.Writer) {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	fmt.Fprintln(w, "Usage: neofs-crypto <command> [flags] [args]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-10s %s\n", name, commands[name].usage)
+	}
+}
+
+// newFlagSet returns flag set printing errors and usage into stderr.
+func newFlagSet(e *env, name, args string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(e.stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(e.stderr, "Usage: neofs-crypto %s [flags] %s\n", name, args)
+		fs.PrintDefaults()
+	}
+
+	return fs
+}
+
+// parseFlags parses flags and returns positional arguments. Flags are
+// accepted both before and after positional arguments.
+func parseFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+
+			return nil, fmt.Errorf("%w: %w", errUsage, err)
+		}
+
+		if args = fs.Args(); len(args) == 0 {
+			return positional, nil
+		}
+
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
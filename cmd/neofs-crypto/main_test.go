@@ -0,0 +1,168 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/stretchr/testify/require"
+)
+
+const testWIF = "KyKwsDQhb6ncTw9wfoJqMXUABTsMLi36u7BZBBKo5uzmGFEHHDVu"
+
+func execute(args ...string) (int, string, string) {
+	return executeWithInput("", args...)
+}
+
+func executeWithInput(stdin string, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+
+	code := run(args, &env{stdin: strings.NewReader(stdin), stdout: &stdout, stderr: &stderr})
+
+	return code, stdout.String(), stderr.String()
+}
+
+func TestUsage(t *testing.T) {
+	code, _, stderr := execute()
+	require.Equal(t, exitUsage, code)
+	require.Contains(t, stderr, "inspect")
+
+	code, _, stderr = execute("unknown")
+	require.Equal(t, exitUsage, code)
+	require.Contains(t, stderr, `unknown command "unknown"`)
+
+	code, _, _ = execute("pubkey")
+	require.Equal(t, exitUsage, code)
+
+	code, _, _ = execute("convert", "--to", "bad", testWIF)
+	require.Equal(t, exitUsage, code)
+
+	code, _, _ = execute("inspect", "--help")
+	require.Equal(t, exitUsage, code)
+
+	code, _, stderr = execute("inspect", "not a key")
+	require.Equal(t, exitError, code)
+	require.Contains(t, stderr, "unknown key format")
+}
+
+func TestInspect(t *testing.T) {
+	code, stdout, _ := execute("inspect", testWIF)
+	require.Equal(t, exitOK, code)
+	require.Equal(t, ""+
+		"Public key:   0375099c302b77664a2508bec1cae47903857b762c62713f190e8d99912ef76737\n"+
+		"Uncompressed: 0475099c302b77664a2508bec1cae47903857b762c62713f190e8d99912ef76737"+
+		"f36191e4c0ea50e47b0e0edbae24fd6529df84f9bd63f87219df3a086efe9195\n"+
+		"Address:      NTQLXHMkgwA77wpaNUNaX3NMjEs9ioM8GZ\n"+
+		"Script hash:  0x3aee2f068334aac0c3e628a0d16749909f562652\n", stdout)
+}
+
+func TestPubkey(t *testing.T) {
+	code, stdout, _ := execute("pubkey", testWIF)
+	require.Equal(t, exitOK, code)
+	require.Equal(t, "0375099c302b77664a2508bec1cae47903857b762c62713f190e8d99912ef76737\n", stdout)
+
+	code, stdout, _ = execute("pubkey", testWIF, "--uncompressed")
+	require.Equal(t, exitOK, code)
+	require.Len(t, strings.TrimSpace(stdout), 2*crypto.PublicKeyUncompressedSize)
+}
+
+func TestConvert(t *testing.T) {
+	expected, err := crypto.WIFDecode(testWIF)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+
+	for _, format := range []string{"wif", "hex", "pem", "der", "raw", "mnemonic"} {
+		t.Run(format, func(t *testing.T) {
+			code, stdout, stderr := execute("convert", "--to", format, testWIF)
+			require.Equal(t, exitOK, code, stderr)
+
+			path := filepath.Join(dir, format)
+			require.NoError(t, os.WriteFile(path, []byte(stdout), 0o600))
+
+			actual, err := crypto.LoadPrivateKey(path)
+			require.NoError(t, err)
+			require.Equal(t, expected, actual)
+		})
+	}
+
+	code, stdout, _ := execute("convert", "--to", "hex", testWIF)
+	require.Equal(t, exitOK, code)
+	require.Equal(t, "3ee1fd84dd7199925f8d32f897aaa7f2d6484aa3738e5e0abd03f8240d7c6d8c\n", stdout)
+
+	t.Run("stdin", func(t *testing.T) {
+		for _, format := range []string{"wif", "hex", "pem", "der", "raw", "mnemonic"} {
+			_, encoded, _ := execute("convert", "--to", format, testWIF)
+
+			code, stdout, stderr := executeWithInput(encoded, "convert", "--to", "hex", "-")
+			require.Equal(t, exitOK, code, stderr)
+			require.Equal(t, "3ee1fd84dd7199925f8d32f897aaa7f2d6484aa3738e5e0abd03f8240d7c6d8c\n", stdout, format)
+		}
+
+		code, _, stderr := executeWithInput("not a key", "inspect", "-")
+		require.Equal(t, exitError, code)
+		require.Contains(t, stderr, crypto.ErrBadKeyFile.Error())
+
+		code, _, _ = executeWithInput(testWIF, "sign", "-")
+		require.Equal(t, exitUsage, code)
+	})
+}
+
+func TestGen(t *testing.T) {
+	code, stdout, _ := execute("gen")
+	require.Equal(t, exitOK, code)
+
+	_, err := crypto.WIFDecode(strings.TrimSpace(stdout))
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "key")
+
+	code, _, _ = execute("gen", "--format", "pem", "--out", path)
+	require.Equal(t, exitOK, code)
+
+	first, err := crypto.LoadPrivateKey(path)
+	require.NoError(t, err)
+
+	code, _, stderr := execute("gen", "--out", path)
+	require.Equal(t, exitError, code)
+	require.Contains(t, stderr, crypto.ErrKeyFileExists.Error())
+
+	code, _, _ = execute("gen", "--out", path, "--force")
+	require.Equal(t, exitOK, code)
+
+	second, err := crypto.LoadPrivateKey(path)
+	require.NoError(t, err)
+	require.NotEqual(t, first.D, second.D)
+
+	t.Run("mnemonic", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "key")
+		require.NoError(t, os.WriteFile(path, nil, 0o644))
+
+		code, _, stderr := execute("gen", "--format", "mnemonic", "--out", path)
+		require.Equal(t, exitError, code)
+		require.Contains(t, stderr, crypto.ErrKeyFileExists.Error())
+
+		code, _, stderr = execute("gen", "--format", "mnemonic", "--out", path, "--force")
+		require.Equal(t, exitOK, code, stderr)
+
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+		_, err = crypto.LoadPrivateKey(path)
+		require.NoError(t, err)
+	})
+
+	code, stdout, _ = execute("gen", "--vanity", "N")
+	require.Equal(t, exitOK, code)
+	require.NotEmpty(t, stdout)
+
+	for _, prefix := range []string{"X", "Nz"} {
+		code, _, stderr = execute("gen", "--vanity", prefix)
+		require.Equal(t, exitError, code)
+		require.Contains(t, stderr, crypto.ErrBadVanityPrefix.Error())
+	}
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+)
+
+func runPubkey(e *env, args []string) error {
+	fs := newFlagSet(e, "pubkey", "<key>")
+	uncompressed := fs.Bool("uncompressed", false, "print uncompressed public key")
+
+	rest, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	key, err := loadKey(e, rest)
+	if err != nil {
+		return err
+	}
+
+	defer crypto.DestroyPrivateKey(key)
+
+	pub := crypto.MarshalPublicKey(&key.PublicKey)
+	if *uncompressed {
+		pub = marshalUncompressed(key)
+	}
+
+	_, err = fmt.Fprintln(e.stdout, hex.EncodeToString(pub))
+
+	return err
+}
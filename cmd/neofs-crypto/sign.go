@@ -0,0 +1,151 @@
+package main
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"strings"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+)
+
+const (
+	schemeECDSA    = "ecdsa"
+	schemeRFC6979  = "rfc6979"
+	encodingHex    = "hex"
+	encodingBase64 = "base64"
+	encodingRaw    = "raw"
+)
+
+// scheme describes signature scheme. Messages are hashed as a stream, so
+// signatures are the same as crypto.Sign and crypto.SignRFC6979 produce
+// for the whole message in memory.
+type scheme struct {
+	hash   func() hash.Hash
+	sign   func(*ecdsa.PrivateKey, []byte) ([]byte, error)
+	verify func(*ecdsa.PublicKey, []byte, []byte) error
+}
+
+var schemes = map[string]scheme{
+	schemeECDSA:   {hash: sha512.New, sign: crypto.SignHash, verify: crypto.VerifyHash},
+	schemeRFC6979: {hash: sha256.New, sign: crypto.SignRFC6979Hash, verify: crypto.VerifyRFC6979Hash},
+}
+
+func runSign(e *env, args []string) error {
+	fs := newFlagSet(e, "sign", "<key>")
+	schemeName := fs.String("scheme", schemeRFC6979, "signature scheme: ecdsa (SHA-512, random nonce) or rfc6979 (SHA-256, deterministic)")
+	encoding := fs.String("encoding", encodingHex, "signature encoding: hex, base64 or raw")
+	in := fs.String("in", "-", "file to sign, - for stdin")
+	out := fs.String("out", "", "write signature into file instead of stdout")
+
+	rest, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	sch, err := getScheme(*schemeName)
+	if err != nil {
+		return err
+	} else if err = checkEncoding(*encoding); err != nil {
+		return err
+	} else if *in == "-" && len(rest) == 1 && rest[0] == "-" {
+		return fmt.Errorf("%w: key and input can't both be read from stdin", errUsage)
+	}
+
+	key, err := loadKey(e, rest)
+	if err != nil {
+		return err
+	}
+
+	defer crypto.DestroyPrivateKey(key)
+
+	sum, err := hashInput(e, sch, *in)
+	if err != nil {
+		return err
+	}
+
+	sig, err := sch.sign(key, sum)
+	if err != nil {
+		return err
+	}
+
+	data := encodeSignature(sig, *encoding)
+
+	if *out != "" {
+		return os.WriteFile(*out, data, 0o644)
+	}
+
+	_, err = e.stdout.Write(data)
+
+	return err
+}
+
+func getScheme(name string) (scheme, error) {
+	sch, ok := schemes[strings.ToLower(name)]
+	if !ok {
+		return scheme{}, fmt.Errorf("%w: unknown scheme %q, expect ecdsa or rfc6979", errUsage, name)
+	}
+
+	return sch, nil
+}
+
+func checkEncoding(encoding string) error {
+	switch strings.ToLower(encoding) {
+	case encodingHex, encodingBase64, encodingRaw:
+		return nil
+	default:
+		return fmt.Errorf("%w: unknown encoding %q, expect hex, base64 or raw", errUsage, encoding)
+	}
+}
+
+// hashInput returns the scheme hash of file contents or stdin for "-".
+func hashInput(e *env, sch scheme, path string) ([]byte, error) {
+	r := e.stdin
+
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+
+		defer f.Close()
+
+		r = f
+	}
+
+	h := sch.hash()
+	if _, err := io.Copy(h, r); err != nil {
+		return nil, fmt.Errorf("could not read message: %w", err)
+	}
+
+	return h.Sum(nil), nil
+}
+
+func encodeSignature(sig []byte, encoding string) []byte {
+	switch strings.ToLower(encoding) {
+	case encodingBase64:
+		return []byte(base64.StdEncoding.EncodeToString(sig) + "\n")
+	case encodingHex:
+		return []byte(hex.EncodeToString(sig) + "\n")
+	default:
+		return sig
+	}
+}
+
+func decodeSignature(data []byte, encoding string) ([]byte, error) {
+	switch strings.ToLower(encoding) {
+	case encodingBase64:
+		return base64.StdEncoding.DecodeString(string(bytes.TrimSpace(data)))
+	case encodingHex:
+		return hex.DecodeString(string(bytes.TrimSpace(data)))
+	default:
+		return data, nil
+	}
+}
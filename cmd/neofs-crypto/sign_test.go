@@ -0,0 +1,109 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/stretchr/testify/require"
+)
+
+const testPublicKey = "0375099c302b77664a2508bec1cae47903857b762c62713f190e8d99912ef76737"
+
+func TestSignVerify(t *testing.T) {
+	key, err := crypto.WIFDecode(testWIF)
+	require.NoError(t, err)
+
+	msg := "NeoFS object dump"
+
+	t.Run("rfc6979 from stdin", func(t *testing.T) {
+		code, stdout, stderr := executeWithInput(msg, "sign", testWIF)
+		require.Equal(t, exitOK, code, stderr)
+
+		expected, err := crypto.SignRFC6979(key, []byte(msg))
+		require.NoError(t, err)
+		require.Equal(t, hex.EncodeToString(expected)+"\n", stdout)
+
+		code, stdout, stderr = executeWithInput(msg, "verify", "--sig", strings.TrimSpace(stdout), testPublicKey)
+		require.Equal(t, exitOK, code, stderr)
+		require.Equal(t, "OK\n", stdout)
+	})
+
+	t.Run("ecdsa from file", func(t *testing.T) {
+		dir := t.TempDir()
+		in := filepath.Join(dir, "msg")
+		sigFile := filepath.Join(dir, "sig")
+		require.NoError(t, os.WriteFile(in, []byte(msg), 0o600))
+
+		code, _, stderr := execute("sign", "--scheme", "ecdsa", "--encoding", "raw", "--in", in, "--out", sigFile, testWIF)
+		require.Equal(t, exitOK, code, stderr)
+
+		sig, err := os.ReadFile(sigFile)
+		require.NoError(t, err)
+		require.NoError(t, crypto.Verify(&key.PublicKey, []byte(msg), sig))
+
+		code, _, stderr = execute("verify", "--scheme", "ecdsa", "--encoding", "raw", "--in", in, "--sig", sigFile, testWIF)
+		require.Equal(t, exitOK, code, stderr)
+	})
+
+	t.Run("base64", func(t *testing.T) {
+		code, stdout, _ := executeWithInput(msg, "sign", "--encoding", "base64", testWIF)
+		require.Equal(t, exitOK, code)
+
+		sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(stdout))
+		require.NoError(t, err)
+		require.NoError(t, crypto.VerifyRFC6979(&key.PublicKey, []byte(msg), sig))
+	})
+
+	t.Run("exit codes", func(t *testing.T) {
+		sig, err := crypto.SignRFC6979(key, []byte(msg))
+		require.NoError(t, err)
+
+		code, _, _ := executeWithInput("other", "verify", "--sig", hex.EncodeToString(sig), testPublicKey)
+		require.Equal(t, exitInvalidSignature, code)
+
+		code, _, _ = executeWithInput(msg, "verify", "--sig", hex.EncodeToString(sig[1:]), testPublicKey)
+		require.Equal(t, exitWrongHashSize, code)
+
+		code, _, stderr := executeWithInput(msg, "verify", "--sig", "not hex", testPublicKey)
+		require.Equal(t, exitCannotUnmarshal, code)
+		require.Contains(t, stderr, "not hex")
+
+		missing := filepath.Join(t.TempDir(), "missing.sig")
+		code, _, stderr = executeWithInput(msg, "verify", "--sig", missing, testPublicKey)
+		require.Equal(t, exitCannotUnmarshal, code)
+		require.Contains(t, stderr, missing)
+
+		code, _, stderr = executeWithInput(msg, "verify", "--sig", hex.EncodeToString(sig), "not a key")
+		require.Equal(t, exitError, code)
+		require.Contains(t, stderr, "unknown key format")
+
+		code, _, _ = executeWithInput(msg, "verify", "--encoding", "raw", "--sig", string(sig), testPublicKey)
+		require.Equal(t, exitError, code)
+
+		malformed := filepath.Join(t.TempDir(), "malformed.sig")
+		require.NoError(t, os.WriteFile(malformed, []byte("not hex"), 0o644))
+
+		code, _, _ = executeWithInput(msg, "verify", "--sig", malformed, testPublicKey)
+		require.Equal(t, exitCannotUnmarshal, code)
+
+		ecdsaSig, err := crypto.Sign(key, []byte(msg))
+		require.NoError(t, err)
+
+		code, _, _ = executeWithInput("other", "verify", "--scheme", "ecdsa", "--sig", hex.EncodeToString(ecdsaSig), testPublicKey)
+		require.Equal(t, exitInvalidSignature, code)
+
+		code, _, _ = executeWithInput(msg, "verify", "--scheme", "ecdsa", "--sig", hex.EncodeToString(sig), testPublicKey)
+		require.Equal(t, exitCannotUnmarshal, code)
+
+		code, _, _ = executeWithInput(msg, "verify", testPublicKey)
+		require.Equal(t, exitUsage, code)
+
+		code, _, _ = executeWithInput(msg, "sign", "--scheme", "bad", testWIF)
+		require.Equal(t, exitUsage, code)
+	})
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+)
+
+func runVerify(e *env, args []string) error {
+	fs := newFlagSet(e, "verify", "<public key>")
+	schemeName := fs.String("scheme", schemeRFC6979, "signature scheme: ecdsa or rfc6979")
+	encoding := fs.String("encoding", encodingHex, "signature encoding: hex, base64 or raw")
+	in := fs.String("in", "-", "signed file, - for stdin")
+	sigArg := fs.String("sig", "", "signature file or hex/base64 encoded signature value")
+
+	rest, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	sch, err := getScheme(*schemeName)
+	if err != nil {
+		return err
+	} else if err = checkEncoding(*encoding); err != nil {
+		return err
+	} else if *sigArg == "" {
+		return fmt.Errorf("%w: signature is required", errUsage)
+	} else if len(rest) != 1 {
+		return fmt.Errorf("%w: expected exactly one public key argument", errUsage)
+	}
+
+	pub, err := loadPublicKey(rest[0])
+	if err != nil {
+		return err
+	}
+
+	sig, err := readSignature(*sigArg, *encoding)
+	if err != nil {
+		return err
+	}
+
+	sum, err := hashInput(e, sch, *in)
+	if err != nil {
+		return err
+	}
+
+	if err = sch.verify(pub, sum, sig); err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(e.stdout, "OK")
+
+	return err
+}
+
+// readSignature reads signature from file. If file can't be read, the value
+// itself is decoded as a signature (never for raw encoding). Malformed
+// signature is reported as crypto.ErrCannotUnmarshal in both cases.
+func readSignature(val, encoding string) ([]byte, error) {
+	data, err := os.ReadFile(val)
+	if err != nil {
+		if strings.EqualFold(encoding, encodingRaw) {
+			return nil, err
+		}
+
+		sig, decErr := decodeSignature([]byte(val), encoding)
+		if decErr != nil {
+			return nil, fmt.Errorf("%w: %w (%w)", crypto.ErrCannotUnmarshal, decErr, err)
+		}
+
+		return sig, nil
+	}
+
+	sig, err := decodeSignature(data, encoding)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", crypto.ErrCannotUnmarshal, err)
+	}
+
+	return sig, nil
+}
+
+// loadPublicKey reads public key from hex string, file with hex or binary
+// public key or any private key form accepted by crypto.LoadPrivateKey.
+func loadPublicKey(val string) (*ecdsa.PublicKey, error) {
+	data, err := os.ReadFile(val)
+	if err != nil {
+		data = []byte(val)
+	}
+
+	if pub := crypto.UnmarshalPublicKey(data); pub != nil {
+		return pub, nil
+	} else if raw, err := hex.DecodeString(string(bytes.TrimSpace(data))); err == nil {
+		if pub = crypto.UnmarshalPublicKey(raw); pub != nil {
+			return pub, nil
+		}
+	}
+
+	key, err := crypto.LoadPrivateKey(val)
+	if err != nil {
+		return nil, fmt.Errorf("unknown public key format, expect hex-string, file-path or private key: %w", err)
+	}
+
+	defer crypto.DestroyPrivateKey(key)
+
+	return &key.PublicKey, nil
+}
@@ -0,0 +1,291 @@
+/*
+Package delegation implements key delegation certificates. Long-term owner
+key authorizes short-lived ephemeral key for the given scopes and validity
+window once, then only ephemeral key is used at runtime.
+
+Certificate is signed by owner key with crypto.SignRFC6979WithDomain.
+Messages are signed by ephemeral key with crypto.SignRFC6979 as usual,
+Verifier checks the whole chain: owner -> certificate -> ephemeral key ->
+message.
+
+Certificate format (version 1):
+
+	version (1 byte)
+	owner public key (33 bytes, compressed)
+	ephemeral public key (33 bytes, compressed)
+	not before (8 bytes, big-endian Unix seconds)
+	expires at (8 bytes, big-endian Unix seconds)
+	number of scopes (1 byte)
+	scopes: length (1 byte) || name
+	signature (64 bytes)
+
+All fields except the signature are signed.
+*/
+package delegation
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"fmt"
+	"time"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/internal"
+)
+
+const (
+	// Version is the current version of certificate format.
+	Version = 1
+
+	// MaxScopes is the maximal number of certificate scopes.
+	MaxScopes = 0xff
+
+	// MaxScopeSize is the maximal length of scope name.
+	MaxScopeSize = 0xff
+
+	headerSize = 1 + 2*crypto.PublicKeyCompressedSize + 2*internal.UnixSize + 1
+
+	// ErrBadWindow when certificate validity window is empty.
+	ErrBadWindow = internal.Error("bad validity window")
+
+	// ErrBadScope when scope is empty, too long or repeated, or there are
+	// too many scopes.
+	ErrBadScope = internal.Error("bad scope")
+
+	// ErrBadCertificate when certificate can't be decoded.
+	ErrBadCertificate = internal.Error("bad delegation certificate")
+
+	// ErrUnsupportedVersion when certificate has unknown version.
+	ErrUnsupportedVersion = internal.Error("unsupported certificate version")
+
+	// ErrOwnerMismatch when certificate is issued by another owner.
+	ErrOwnerMismatch = internal.Error("certificate owner mismatch")
+
+	// ErrBadSignature when certificate signature is invalid.
+	ErrBadSignature = internal.Error("bad certificate signature")
+
+	// ErrNotYetValid when current time is before certificate NotBefore.
+	ErrNotYetValid = internal.Error("certificate is not yet valid")
+
+	// ErrExpired when current time is not before certificate ExpiresAt.
+	ErrExpired = internal.Error("certificate is expired")
+
+	// ErrScopeNotAllowed when certificate doesn't allow the scope.
+	ErrScopeNotAllowed = internal.Error("scope is not allowed")
+
+	// ErrBadMessageSignature when message signature of ephemeral key is invalid.
+	ErrBadMessageSignature = internal.Error("bad message signature")
+)
+
+var domain = crypto.MustRegisterDomain("neofs-crypto/delegation/v1")
+
+// Certificate authorizes Ephemeral key to sign on behalf of Owner in the
+// given Scopes during [NotBefore, ExpiresAt) time range.
+type Certificate struct {
+	Owner     *ecdsa.PublicKey
+	Ephemeral *ecdsa.PublicKey
+	NotBefore time.Time
+	ExpiresAt time.Time
+	Scopes    []string
+	Signature []byte
+}
+
+// Delegate issues certificate for ephemeral key signed by owner key. Times
+// are truncated to seconds.
+func Delegate(owner *ecdsa.PrivateKey, ephemeral *ecdsa.PublicKey, notBefore, expiresAt time.Time, scopes ...string) (*Certificate, error) {
+	if owner == nil {
+		return nil, crypto.ErrEmptyPrivateKey
+	} else if ephemeral == nil {
+		return nil, crypto.ErrEmptyPublicKey
+	}
+
+	c := &Certificate{
+		Owner:     &ecdsa.PublicKey{Curve: owner.Curve, X: owner.X, Y: owner.Y},
+		Ephemeral: ephemeral,
+		NotBefore: internal.TruncateTime(notBefore),
+		ExpiresAt: internal.TruncateTime(expiresAt),
+		Scopes:    append([]string(nil), scopes...),
+	}
+
+	if err := c.check(); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if c.Signature, err = crypto.SignRFC6979WithDomain(owner, domain, c.signedData()); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Allows returns true if scope is listed in the certificate.
+func (c *Certificate) Allows(scope string) bool {
+	for i := range c.Scopes {
+		if c.Scopes[i] == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *Certificate) check() error {
+	if !c.ExpiresAt.After(c.NotBefore) {
+		return fmt.Errorf("%w: not before %s, expires at %s", ErrBadWindow, c.NotBefore, c.ExpiresAt)
+	} else if ln := len(c.Scopes); ln == 0 || ln > MaxScopes {
+		return fmt.Errorf("%w: %d scopes, expect 1..%d", ErrBadScope, ln, MaxScopes)
+	}
+
+	for i, scope := range c.Scopes {
+		if ln := len(scope); ln == 0 || ln > MaxScopeSize {
+			return fmt.Errorf("%w: length=%d, expect 1..%d", ErrBadScope, ln, MaxScopeSize)
+		}
+
+		for j := 0; j < i; j++ {
+			if c.Scopes[j] == scope {
+				return fmt.Errorf("%w: repeated %q", ErrBadScope, scope)
+			}
+		}
+	}
+
+	return nil
+}
+
+// appendFields appends all certificate fields except the signature.
+func (c *Certificate) appendFields(buf []byte) []byte {
+	buf = append(buf, Version)
+	buf = append(buf, crypto.MarshalPublicKey(c.Owner)...)
+	buf = append(buf, crypto.MarshalPublicKey(c.Ephemeral)...)
+	buf = internal.AppendUnix(buf, c.NotBefore)
+	buf = internal.AppendUnix(buf, c.ExpiresAt)
+	buf = append(buf, byte(len(c.Scopes)))
+
+	for _, scope := range c.Scopes {
+		buf = append(buf, byte(len(scope)))
+		buf = append(buf, scope...)
+	}
+
+	return buf
+}
+
+func (c *Certificate) signedData() []byte {
+	return c.appendFields(nil)
+}
+
+// Bytes returns binary form of the certificate.
+func (c *Certificate) Bytes() []byte {
+	return append(c.appendFields(nil), c.Signature...)
+}
+
+// Decode parses binary form of the certificate.
+func Decode(data []byte) (*Certificate, error) {
+	if len(data) < headerSize+crypto.RFC6979SignatureSize {
+		return nil, fmt.Errorf("%w: too short", ErrBadCertificate)
+	} else if data[0] != Version {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, data[0])
+	}
+
+	c := new(Certificate)
+	off := 1
+
+	if c.Owner = crypto.UnmarshalPublicKey(data[off : off+crypto.PublicKeyCompressedSize]); c.Owner == nil {
+		return nil, fmt.Errorf("%w: bad owner key", ErrBadCertificate)
+	}
+
+	off += crypto.PublicKeyCompressedSize
+
+	if c.Ephemeral = crypto.UnmarshalPublicKey(data[off : off+crypto.PublicKeyCompressedSize]); c.Ephemeral == nil {
+		return nil, fmt.Errorf("%w: bad ephemeral key", ErrBadCertificate)
+	}
+
+	off += crypto.PublicKeyCompressedSize
+	c.NotBefore = internal.DecodeUnix(data[off:])
+	off += internal.UnixSize
+	c.ExpiresAt = internal.DecodeUnix(data[off:])
+	off += internal.UnixSize
+
+	n := int(data[off])
+	off++
+	c.Scopes = make([]string, 0, n)
+
+	for i := 0; i < n; i++ {
+		if off >= len(data) {
+			return nil, fmt.Errorf("%w: truncated scopes", ErrBadCertificate)
+		}
+
+		ln := int(data[off])
+		off++
+
+		if len(data)-off < ln {
+			return nil, fmt.Errorf("%w: truncated scopes", ErrBadCertificate)
+		}
+
+		c.Scopes = append(c.Scopes, string(data[off:off+ln]))
+		off += ln
+	}
+
+	if ln := len(data) - off; ln != crypto.RFC6979SignatureSize {
+		return nil, fmt.Errorf("%w: signature size actual=%d, expect=%d",
+			ErrBadCertificate, ln, crypto.RFC6979SignatureSize)
+	} else if err := c.check(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBadCertificate, err)
+	}
+
+	c.Signature = append([]byte(nil), data[off:]...)
+
+	return c, nil
+}
+
+// Verifier checks delegation chains against the clock.
+type Verifier struct {
+	clock crypto.Clock
+}
+
+// NewVerifier returns verifier using the given clock, nil clock means
+// crypto.SystemClock.
+func NewVerifier(clock crypto.Clock) *Verifier {
+	return &Verifier{clock: clock}
+}
+
+// VerifyCertificate checks that certificate is issued by owner, allows the
+// scope and is valid at the current time. Signature is checked before the
+// time and scope, so ErrNotYetValid, ErrExpired and ErrScopeNotAllowed are
+// returned for authentic certificates only.
+func (v *Verifier) VerifyCertificate(owner *ecdsa.PublicKey, c *Certificate, scope string) error {
+	if owner == nil {
+		return crypto.ErrEmptyPublicKey
+	} else if c.Owner == nil || !bytes.Equal(crypto.MarshalPublicKey(owner), crypto.MarshalPublicKey(c.Owner)) {
+		return ErrOwnerMismatch
+	} else if c.Ephemeral == nil {
+		return fmt.Errorf("%w: %w", ErrBadCertificate, crypto.ErrEmptyPublicKey)
+	} else if err := c.check(); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadCertificate, err)
+	} else if err = crypto.VerifyRFC6979WithDomain(owner, domain, c.signedData(), c.Signature); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadSignature, err)
+	}
+
+	now := internal.Now(v.clock)
+
+	if now.Before(c.NotBefore) {
+		return fmt.Errorf("%w: now %s, not before %s", ErrNotYetValid, now.UTC(), c.NotBefore)
+	} else if !now.Before(c.ExpiresAt) {
+		return fmt.Errorf("%w: now %s, expires at %s", ErrExpired, now.UTC(), c.ExpiresAt)
+	} else if !c.Allows(scope) {
+		return fmt.Errorf("%w: %q", ErrScopeNotAllowed, scope)
+	}
+
+	return nil
+}
+
+// Verify checks delegation chain: certificate by VerifyCertificate, then
+// RFC6979 signature of msg made by certificate ephemeral key.
+func (v *Verifier) Verify(owner *ecdsa.PublicKey, c *Certificate, scope string, msg, sig []byte) error {
+	if err := v.VerifyCertificate(owner, c, scope); err != nil {
+		return err
+	} else if err = crypto.VerifyRFC6979(c.Ephemeral, msg, sig); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadMessageSignature, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package delegation
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/stretchr/testify/require"
+)
+
+func fixedClock(t time.Time) crypto.Clock {
+	return crypto.ClockFunc(func() time.Time { return t })
+}
+
+func TestDelegation(t *testing.T) {
+	owner := test.DecodeKey(1)
+	ephemeral := test.DecodeKey(2)
+
+	notBefore := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	expires := notBefore.Add(time.Hour)
+	now := notBefore.Add(time.Minute)
+
+	cert, err := Delegate(owner, &ephemeral.PublicKey, notBefore, expires, "object.put", "object.get")
+	require.NoError(t, err)
+	require.True(t, cert.Allows("object.get"))
+	require.False(t, cert.Allows("container.delete"))
+
+	msg := []byte("object header")
+	sig, err := crypto.SignRFC6979(ephemeral, msg)
+	require.NoError(t, err)
+
+	v := NewVerifier(fixedClock(now))
+	require.NoError(t, v.Verify(&owner.PublicKey, cert, "object.put", msg, sig))
+
+	t.Run("encoding", func(t *testing.T) {
+		data := cert.Bytes()
+		require.Len(t, data, headerSize+2+len("object.put")+len("object.get")+crypto.RFC6979SignatureSize)
+
+		decoded, err := Decode(data)
+		require.NoError(t, err)
+		require.Equal(t, cert, decoded)
+		require.NoError(t, v.Verify(&owner.PublicKey, decoded, "object.get", msg, sig))
+
+		for _, ln := range []int{0, headerSize, len(data) - 1, headerSize + crypto.RFC6979SignatureSize} {
+			_, err = Decode(data[:ln])
+			require.ErrorIs(t, err, ErrBadCertificate, ln)
+		}
+
+		_, err = Decode(append(data, 0))
+		require.ErrorIs(t, err, ErrBadCertificate)
+
+		bad := append([]byte(nil), data...)
+		bad[0]++
+		_, err = Decode(bad)
+		require.ErrorIs(t, err, ErrUnsupportedVersion)
+	})
+
+	t.Run("chain", func(t *testing.T) {
+		err := v.Verify(&owner.PublicKey, cert, "object.put", []byte("other header"), sig)
+		require.ErrorIs(t, err, ErrBadMessageSignature)
+
+		ownerSig, err := crypto.SignRFC6979(owner, msg)
+		require.NoError(t, err)
+		require.ErrorIs(t, v.Verify(&owner.PublicKey, cert, "object.put", msg, ownerSig), ErrBadMessageSignature)
+
+		require.ErrorIs(t, v.Verify(&test.DecodeKey(3).PublicKey, cert, "object.put", msg, sig), ErrOwnerMismatch)
+		require.ErrorIs(t, v.Verify(&owner.PublicKey, cert, "container.delete", msg, sig), ErrScopeNotAllowed)
+		require.ErrorIs(t, v.Verify(nil, cert, "object.put", msg, sig), crypto.ErrEmptyPublicKey)
+	})
+
+	t.Run("window", func(t *testing.T) {
+		err := NewVerifier(fixedClock(notBefore.Add(-time.Second))).VerifyCertificate(&owner.PublicKey, cert, "object.put")
+		require.ErrorIs(t, err, ErrNotYetValid)
+
+		err = NewVerifier(fixedClock(expires)).VerifyCertificate(&owner.PublicKey, cert, "object.put")
+		require.ErrorIs(t, err, ErrExpired)
+
+		require.NoError(t, NewVerifier(fixedClock(notBefore)).VerifyCertificate(&owner.PublicKey, cert, "object.put"))
+	})
+
+	t.Run("tampering", func(t *testing.T) {
+		for name, change := range map[string]func(c *Certificate){
+			"ephemeral": func(c *Certificate) { c.Ephemeral = &test.DecodeKey(3).PublicKey },
+			"expires":   func(c *Certificate) { c.ExpiresAt = c.ExpiresAt.Add(time.Hour) },
+			"scopes":    func(c *Certificate) { c.Scopes = append(c.Scopes, "container.delete") },
+		} {
+			changed := *cert
+			change(&changed)
+
+			err := v.VerifyCertificate(&owner.PublicKey, &changed, "object.put")
+			require.ErrorIs(t, err, ErrBadSignature, name)
+			require.ErrorIs(t, err, crypto.ErrWrongSignature, name)
+		}
+
+		plain, err := crypto.SignRFC6979(owner, cert.signedData())
+		require.NoError(t, err)
+
+		undomained := *cert
+		undomained.Signature = plain
+		require.ErrorIs(t, v.VerifyCertificate(&owner.PublicKey, &undomained, "object.put"), ErrBadSignature)
+
+		selfSigned, err := Delegate(ephemeral, &ephemeral.PublicKey, notBefore, expires, "object.put")
+		require.NoError(t, err)
+
+		selfSigned.Owner = &owner.PublicKey
+		require.ErrorIs(t, v.VerifyCertificate(&owner.PublicKey, selfSigned, "object.put"), ErrBadSignature)
+	})
+
+	t.Run("system clock", func(t *testing.T) {
+		cert, err := Delegate(owner, &ephemeral.PublicKey, time.Now().Add(-time.Minute), time.Now().Add(time.Hour), "object.put")
+		require.NoError(t, err)
+
+		require.NoError(t, NewVerifier(nil).Verify(&owner.PublicKey, cert, "object.put", msg, sig))
+		require.NoError(t, new(Verifier).Verify(&owner.PublicKey, cert, "object.put", msg, sig))
+	})
+
+	t.Run("bad input", func(t *testing.T) {
+		_, err := Delegate(nil, &ephemeral.PublicKey, notBefore, expires, "a")
+		require.ErrorIs(t, err, crypto.ErrEmptyPrivateKey)
+
+		_, err = Delegate(owner, nil, notBefore, expires, "a")
+		require.ErrorIs(t, err, crypto.ErrEmptyPublicKey)
+
+		_, err = Delegate(owner, &ephemeral.PublicKey, expires, notBefore, "a")
+		require.ErrorIs(t, err, ErrBadWindow)
+
+		for _, scopes := range [][]string{
+			nil,
+			{""},
+			{strings.Repeat("x", MaxScopeSize+1)},
+			{"a", "b", "a"},
+			make([]string, MaxScopes+1),
+		} {
+			_, err = Delegate(owner, &ephemeral.PublicKey, notBefore, expires, scopes...)
+			require.ErrorIs(t, err, ErrBadScope)
+		}
+	})
+}
@@ -0,0 +1,159 @@
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"hash"
+	"sync"
+
+	"github.com/nspcc-dev/neofs-crypto/internal"
+)
+
+const (
+	// MaxDomainSize is the maximal length of domain separation tag.
+	MaxDomainSize = 0xff
+
+	// ErrBadDomain when domain separation tag is empty or too long.
+	ErrBadDomain = internal.Error("bad signature domain")
+
+	// ErrDomainRegistered when domain separation tag is already registered.
+	ErrDomainRegistered = internal.Error("signature domain is already registered")
+)
+
+// Domain is a domain separation tag. Signatures made for one domain are
+// never valid for another, even for the same message bytes. Domains with the
+// same tag are equal.
+//
+// Package defining signed structure owns the tag and obtains Domain by
+// RegisterDomain, so another structure can't claim the same tag by mistake.
+// Code verifying (or producing) signatures of structures it doesn't own uses
+// DeclareDomain with the same tag.
+type Domain struct {
+	name string
+}
+
+var domains = struct {
+	sync.Mutex
+	names map[string]struct{}
+}{names: make(map[string]struct{})}
+
+// RegisterDomain claims exclusive ownership of domain separation tag, e.g.
+// "neofs/bearer-token/v1". Each tag can be registered only once per process,
+// so two structures can't share it by accident. Tag can still be declared by
+// DeclareDomain any number of times.
+func RegisterDomain(name string) (Domain, error) {
+	d, err := DeclareDomain(name)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	domains.Lock()
+	defer domains.Unlock()
+
+	if _, ok := domains.names[name]; ok {
+		return Domain{}, fmt.Errorf("%w: %q", ErrDomainRegistered, name)
+	}
+
+	domains.names[name] = struct{}{}
+
+	return d, nil
+}
+
+// DeclareDomain returns domain with the given tag without claiming its
+// ownership. It is used to verify signatures of structures defined by other
+// packages, so tag may be registered by its owner or not.
+func DeclareDomain(name string) (Domain, error) {
+	if ln := len(name); ln == 0 || ln > MaxDomainSize {
+		return Domain{}, fmt.Errorf("%w: length=%d, expect 1..%d", ErrBadDomain, ln, MaxDomainSize)
+	}
+
+	return Domain{name: name}, nil
+}
+
+// MustRegisterDomain is like RegisterDomain but panics on error. It is
+// intended for package-level variables.
+func MustRegisterDomain(name string) Domain {
+	d, err := RegisterDomain(name)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+// MustDeclareDomain is like DeclareDomain but panics on error. It is
+// intended for package-level variables.
+func MustDeclareDomain(name string) Domain {
+	d, err := DeclareDomain(name)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+// String returns domain separation tag.
+func (d Domain) String() string {
+	return d.name
+}
+
+// hash returns h(len || domain || msg), where len is a single byte.
+func (d Domain) hash(h hash.Hash, msg []byte) ([]byte, error) {
+	if d.name == "" {
+		return nil, fmt.Errorf("%w: empty domain", ErrBadDomain)
+	}
+
+	h.Write([]byte{byte(len(d.name))})
+	h.Write([]byte(d.name))
+	h.Write(msg)
+
+	return h.Sum(nil), nil
+}
+
+// SignWithDomain signs SHA-512 of len || domain || msg by SignHash.
+func SignWithDomain(key *ecdsa.PrivateKey, domain Domain, msg []byte) ([]byte, error) {
+	if key == nil {
+		return nil, ErrEmptyPrivateKey
+	}
+
+	h, err := domain.hash(sha512.New(), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return SignHash(key, h)
+}
+
+// VerifyWithDomain verifies signature made by SignWithDomain. It returns
+// nil only if signature is valid.
+func VerifyWithDomain(pub *ecdsa.PublicKey, domain Domain, msg, sig []byte) error {
+	h, err := domain.hash(sha512.New(), msg)
+	if err != nil {
+		return err
+	}
+
+	return VerifyHash(pub, h, sig)
+}
+
+// SignRFC6979WithDomain signs SHA-256 of len || domain || msg by SignRFC6979Hash.
+func SignRFC6979WithDomain(key *ecdsa.PrivateKey, domain Domain, msg []byte) ([]byte, error) {
+	h, err := domain.hash(sha256.New(), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return SignRFC6979Hash(key, h)
+}
+
+// VerifyRFC6979WithDomain verifies signature made by SignRFC6979WithDomain.
+// It returns nil only if signature is valid.
+func VerifyRFC6979WithDomain(pub *ecdsa.PublicKey, domain Domain, msg, sig []byte) error {
+	h, err := domain.hash(sha256.New(), msg)
+	if err != nil {
+		return err
+	}
+
+	return VerifyRFC6979Hash(pub, h, sig)
+}
@@ -0,0 +1,101 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"strings"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testBearerDomain  = MustRegisterDomain("neofs-crypto/test/bearer-token")
+	testSessionDomain = MustRegisterDomain("neofs-crypto/test/session-token")
+	testDomainAB      = MustRegisterDomain("neofs-crypto/test/ab")
+	testDomainA       = MustRegisterDomain("neofs-crypto/test/a")
+
+	testDeclaredDomain = MustDeclareDomain("neofs-crypto/test/declared")
+	testOwnedDomain    = MustRegisterDomain("neofs-crypto/test/declared")
+)
+
+func TestDomain(t *testing.T) {
+	key := test.DecodeKey(1)
+	msg := []byte("token body")
+
+	t.Run("ecdsa", func(t *testing.T) {
+		sig, err := SignWithDomain(key, testBearerDomain, msg)
+		require.NoError(t, err)
+		require.NoError(t, VerifyWithDomain(&key.PublicKey, testBearerDomain, msg, sig))
+
+		require.ErrorIs(t, VerifyWithDomain(&key.PublicKey, testSessionDomain, msg, sig), ErrInvalidSignature)
+		require.ErrorIs(t, Verify(&key.PublicKey, msg, sig), ErrInvalidSignature)
+
+		h := sha512.Sum512(append([]byte{byte(len(testBearerDomain.String()))}, testBearerDomain.String()+string(msg)...))
+		require.NoError(t, VerifyHash(&key.PublicKey, h[:], sig))
+
+		_, err = SignWithDomain(nil, testBearerDomain, msg)
+		require.ErrorIs(t, err, ErrEmptyPrivateKey)
+	})
+
+	t.Run("rfc6979", func(t *testing.T) {
+		sig, err := SignRFC6979WithDomain(key, testBearerDomain, msg)
+		require.NoError(t, err)
+		require.NoError(t, VerifyRFC6979WithDomain(&key.PublicKey, testBearerDomain, msg, sig))
+
+		require.ErrorIs(t, VerifyRFC6979WithDomain(&key.PublicKey, testSessionDomain, msg, sig), ErrWrongSignature)
+		require.ErrorIs(t, VerifyRFC6979(&key.PublicKey, msg, sig), ErrWrongSignature)
+
+		h := sha256.Sum256(append([]byte{byte(len(testBearerDomain.String()))}, testBearerDomain.String()+string(msg)...))
+		require.NoError(t, VerifyRFC6979Hash(&key.PublicKey, h[:], sig))
+
+		_, err = SignRFC6979WithDomain(nil, testBearerDomain, msg)
+		require.ErrorIs(t, err, ErrEmptyPrivateKey)
+	})
+
+	t.Run("declared", func(t *testing.T) {
+		sig, err := SignRFC6979WithDomain(key, testBearerDomain, msg)
+		require.NoError(t, err)
+
+		// verifier declares domain owned by another package
+		verifierDomain := MustDeclareDomain("neofs-crypto/test/bearer-token")
+		require.NoError(t, VerifyRFC6979WithDomain(&key.PublicKey, verifierDomain, msg, sig))
+
+		// declared domain can still be registered by its owner
+		require.Equal(t, testDeclaredDomain, testOwnedDomain)
+	})
+
+	t.Run("length prefix", func(t *testing.T) {
+		// without length prefix "ab"+"c" and "a"+"bc" would collide
+		sig, err := SignRFC6979WithDomain(key, testDomainAB, []byte("c"))
+		require.NoError(t, err)
+		require.ErrorIs(t, VerifyRFC6979WithDomain(&key.PublicKey, testDomainA, []byte("bc"), sig), ErrWrongSignature)
+	})
+
+	t.Run("registration", func(t *testing.T) {
+		_, err := RegisterDomain(testBearerDomain.String())
+		require.ErrorIs(t, err, ErrDomainRegistered)
+		require.Panics(t, func() { MustRegisterDomain(testBearerDomain.String()) })
+
+		declared, err := DeclareDomain(testBearerDomain.String())
+		require.NoError(t, err)
+		require.Equal(t, testBearerDomain, declared)
+		require.NotPanics(t, func() { MustDeclareDomain(testBearerDomain.String()) })
+
+		_, err = DeclareDomain("")
+		require.ErrorIs(t, err, ErrBadDomain)
+		require.Panics(t, func() { MustDeclareDomain("") })
+
+		_, err = RegisterDomain("")
+		require.ErrorIs(t, err, ErrBadDomain)
+
+		_, err = RegisterDomain(strings.Repeat("x", MaxDomainSize+1))
+		require.ErrorIs(t, err, ErrBadDomain)
+
+		_, err = SignRFC6979WithDomain(key, Domain{}, msg)
+		require.ErrorIs(t, err, ErrBadDomain)
+
+		require.ErrorIs(t, VerifyWithDomain(&key.PublicKey, Domain{}, msg, nil), ErrBadDomain)
+	})
+}
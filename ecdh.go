@@ -0,0 +1,68 @@
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"fmt"
+	"io"
+
+	"github.com/nspcc-dev/neofs-crypto/internal"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// SharedSecretSize is the size of secret returned by SharedSecret.
+	SharedSecretSize = 32
+
+	// ErrBadPublicKey when passed public key can't be used for key agreement.
+	ErrBadPublicKey = internal.Error("bad public key")
+)
+
+// SharedSecret derives a secret shared between owners of priv and pub.
+// Raw ECDH output (x-coordinate of priv·pub) is never returned, it is
+// passed through HKDF-SHA256 with the given info, so different protocols
+// get independent secrets from the same key pair.
+func SharedSecret(priv *ecdsa.PrivateKey, pub *ecdsa.PublicKey, info []byte) ([]byte, error) {
+	if priv == nil || priv.D == nil {
+		return nil, ErrEmptyPrivateKey
+	} else if pub == nil || pub.X == nil || pub.Y == nil {
+		return nil, ErrEmptyPublicKey
+	} else if pub.Curve == nil {
+		return nil, fmt.Errorf("%w: empty curve", ErrBadPublicKey)
+	}
+
+	sk, err := priv.ECDH()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPrivateKey, err)
+	}
+
+	pk, err := pub.ECDH()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBadPublicKey, err)
+	}
+
+	raw, err := sk.ECDH(pk)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBadPublicKey, err)
+	}
+
+	defer internal.WipeBytes(raw)
+
+	secret := make([]byte, SharedSecretSize)
+	if _, err = io.ReadFull(hkdf.New(sha256.New, raw, nil, info), secret); err != nil {
+		return nil, err
+	}
+
+	return secret, nil
+}
+
+// SharedSecretBytes is the same as SharedSecret, but accepts public key
+// in any form supported by UnmarshalPublicKey.
+func SharedSecretBytes(priv *ecdsa.PrivateKey, pub, info []byte) ([]byte, error) {
+	pk := UnmarshalPublicKey(pub)
+	if pk == nil {
+		return nil, fmt.Errorf("%w: could not unmarshal", ErrBadPublicKey)
+	}
+
+	return SharedSecret(priv, pk, info)
+}
@@ -0,0 +1,80 @@
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"io"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/hkdf"
+)
+
+func TestSharedSecret(t *testing.T) {
+	var (
+		alice = test.DecodeKey(0)
+		bob   = test.DecodeKey(1)
+		info  = []byte("neofs object encryption")
+	)
+
+	s1, err := SharedSecret(alice, &bob.PublicKey, info)
+	require.NoError(t, err)
+	require.Len(t, s1, SharedSecretSize)
+
+	s2, err := SharedSecret(bob, &alice.PublicKey, info)
+	require.NoError(t, err)
+	require.Equal(t, s1, s2)
+
+	t.Run("kdf output", func(t *testing.T) {
+		x, _ := elliptic.P256().ScalarMult(bob.X, bob.Y, MarshalPrivateKey(alice))
+		raw := x.FillBytes(make([]byte, 32))
+		require.NotEqual(t, raw, s1)
+
+		expected := make([]byte, SharedSecretSize)
+		_, err := io.ReadFull(hkdf.New(sha256.New, raw, nil, info), expected)
+		require.NoError(t, err)
+		require.Equal(t, expected, s1)
+	})
+
+	t.Run("info separates secrets", func(t *testing.T) {
+		s3, err := SharedSecret(alice, &bob.PublicKey, []byte("other"))
+		require.NoError(t, err)
+		require.NotEqual(t, s1, s3)
+	})
+
+	t.Run("public key forms", func(t *testing.T) {
+		compressed := MarshalPublicKey(&bob.PublicKey)
+		uncompressed := marshalXY(elliptic.P256(), bob.X, bob.Y)
+
+		for _, pub := range [][]byte{compressed, uncompressed} {
+			s, err := SharedSecretBytes(alice, pub, info)
+			require.NoError(t, err)
+			require.Equal(t, s1, s)
+		}
+
+		_, err := SharedSecretBytes(alice, compressed[1:], info)
+		require.ErrorIs(t, err, ErrBadPublicKey)
+	})
+
+	t.Run("bad keys", func(t *testing.T) {
+		_, err := SharedSecret(nil, &bob.PublicKey, info)
+		require.ErrorIs(t, err, ErrEmptyPrivateKey)
+
+		_, err = SharedSecret(alice, nil, info)
+		require.ErrorIs(t, err, ErrEmptyPublicKey)
+
+		_, err = SharedSecret(alice, &ecdsa.PublicKey{Curve: elliptic.P256(), X: bob.X}, info)
+		require.ErrorIs(t, err, ErrEmptyPublicKey)
+
+		_, err = SharedSecret(alice, &ecdsa.PublicKey{Curve: elliptic.P256(), Y: bob.Y}, info)
+		require.ErrorIs(t, err, ErrEmptyPublicKey)
+
+		_, err = SharedSecret(alice, &ecdsa.PublicKey{X: bob.X, Y: bob.Y}, info)
+		require.ErrorIs(t, err, ErrBadPublicKey)
+
+		_, err = SharedSecret(alice, &ecdsa.PublicKey{Curve: elliptic.P384(), X: bob.X, Y: bob.Y}, info)
+		require.ErrorIs(t, err, ErrBadPublicKey)
+	})
+}
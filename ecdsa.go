@@ -1,11 +1,13 @@
 package crypto
 
 import (
+	"bytes"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/sha512"
 	"crypto/x509"
+	"encoding/asn1"
 	"fmt"
 	"math/big"
 
@@ -23,6 +25,10 @@ const (
 	// and cannot be parsed.
 	ErrCannotUnmarshal = internal.Error("could not unmarshal signature")
 
+	// ErrInvalidPrivateKey when private key D is out of [1, N) range or
+	// its public key doesn't match D·G.
+	ErrInvalidPrivateKey = internal.Error("invalid private key")
+
 	// PrivateKeyCompressedSize is constant with compressed size of private key (SK).
 	// D coordinate stored, recover PK by formula x, y = curve.ScalarBaseMul(d,bytes).
 	PrivateKeyCompressedSize = 32
@@ -126,9 +132,14 @@ func decodePoint(data []byte) (x *big.Int, y *big.Int) {
 // It is similar to `ecdsa.Generate()` but uses pre-defined big.Int and
 // curve for NEO Blockchain (elliptic.P256)
 // Link - https://golang.org/pkg/crypto/ecdsa/#GenerateKey
+// Keys failing ValidatePrivateKey are rejected with ErrInvalidPrivateKey.
 func UnmarshalPrivateKey(data []byte) (*ecdsa.PrivateKey, error) {
 	if len(data) == PrivateKeyCompressedSize { // todo: consider using only NEO blockchain private keys
 		d := new(big.Int).SetBytes(data)
+		if err := validateScalar(curve, d); err != nil {
+			return nil, err
+		}
+
 		priv := new(ecdsa.PrivateKey)
 		priv.PublicKey.Curve = curve
 		priv.D = d
@@ -137,12 +148,108 @@ func UnmarshalPrivateKey(data []byte) (*ecdsa.PrivateKey, error) {
 		return priv, nil
 	}
 
-	return x509.ParseECPrivateKey(data)
+	priv, err := x509.ParseECPrivateKey(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// x509 recomputes public key from D and drops the embedded one,
+	// so check that they are consistent
+	if pub, err := embeddedPublicKey(data); err != nil {
+		return nil, err
+	} else if pub != nil &&
+		!bytes.Equal(pub, marshalXY(priv.Curve, priv.X, priv.Y)) &&
+		!bytes.Equal(pub, elliptic.MarshalCompressed(priv.Curve, priv.X, priv.Y)) {
+		return nil, fmt.Errorf("%w: embedded public key mismatch", ErrInvalidPrivateKey)
+	}
+
+	if err = ValidatePrivateKey(priv); err != nil {
+		return nil, err
+	}
+
+	return priv, nil
+}
+
+// ecPrivateKey is an ASN.1 structure of SEC 1 private key (RFC 5915).
+type ecPrivateKey struct {
+	Version       int
+	PrivateKey    []byte
+	NamedCurveOID asn1.ObjectIdentifier `asn1:"optional,explicit,tag:0"`
+	PublicKey     asn1.BitString        `asn1:"optional,explicit,tag:1"`
+}
+
+// embeddedPublicKey returns public key stored in SEC 1 / ASN.1 DER
+// private key or nil if it is absent.
+func embeddedPublicKey(der []byte) ([]byte, error) {
+	var key ecPrivateKey
+	if _, err := asn1.Unmarshal(der, &key); err != nil {
+		return nil, err
+	}
+
+	internal.WipeBytes(key.PrivateKey)
+
+	if key.PublicKey.BitLength == 0 {
+		return nil, nil
+	}
+
+	return key.PublicKey.Bytes, nil
+}
+
+// ValidatePrivateKey checks that D of the private key is in [1, N) range
+// and that its public key equals D·G.
+func ValidatePrivateKey(key *ecdsa.PrivateKey) error {
+	if key == nil || key.D == nil {
+		return ErrEmptyPrivateKey
+	} else if key.Curve == nil {
+		return fmt.Errorf("%w: empty curve", ErrInvalidPrivateKey)
+	} else if err := validateScalar(key.Curve, key.D); err != nil {
+		return err
+	} else if key.X == nil || key.Y == nil {
+		return fmt.Errorf("%w: empty public key", ErrInvalidPrivateKey)
+	}
+
+	d := make([]byte, (key.Curve.Params().N.BitLen()+7)/8)
+	defer internal.WipeBytes(d)
+
+	if x, y := key.Curve.ScalarBaseMult(key.D.FillBytes(d)); x.Cmp(key.X) != 0 || y.Cmp(key.Y) != 0 {
+		return fmt.Errorf("%w: public key mismatch", ErrInvalidPrivateKey)
+	}
+
+	return nil
+}
+
+// validateScalar checks that 1 <= d < N.
+func validateScalar(c elliptic.Curve, d *big.Int) error {
+	if d.Sign() <= 0 {
+		return fmt.Errorf("%w: zero or negative D", ErrInvalidPrivateKey)
+	} else if d.Cmp(c.Params().N) >= 0 {
+		return fmt.Errorf("%w: D is not less than curve order", ErrInvalidPrivateKey)
+	}
+
+	return nil
 }
 
-// MarshalPrivateKey to bytes.
+// MarshalPrivateKey to bytes. Result is PrivateKeyCompressedSize bytes
+// long, D is left-padded with zeros. It returns nil for negative D or D
+// wider than that.
 func MarshalPrivateKey(key *ecdsa.PrivateKey) []byte {
-	return key.D.Bytes()
+	if checkPrivateKeyWidth(key.D) != nil {
+		return nil
+	}
+
+	return key.D.FillBytes(make([]byte, PrivateKeyCompressedSize))
+}
+
+// checkPrivateKeyWidth checks that D is non-negative and fits into
+// PrivateKeyCompressedSize bytes.
+func checkPrivateKeyWidth(d *big.Int) error {
+	if d.Sign() < 0 {
+		return fmt.Errorf("%w: negative D", ErrInvalidPrivateKey)
+	} else if d.BitLen() > PrivateKeyCompressedSize*8 {
+		return fmt.Errorf("%w: D is wider than %d bytes", ErrInvalidPrivateKey, PrivateKeyCompressedSize)
+	}
+
+	return nil
 }
 
 // hashBytes returns the sha512 sum.
@@ -191,3 +191,127 @@ func TestSignVerify(t *testing.T) {
 		}
 	})
 }
+
+func TestValidatePrivateKey(t *testing.T) {
+	n := elliptic.P256().Params().N
+
+	t.Run("valid keys", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			require.NoError(t, ValidatePrivateKey(test.DecodeKey(i)))
+		}
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		for _, d := range []*big.Int{
+			big.NewInt(0),
+			new(big.Int).Set(n),
+			new(big.Int).Add(n, big.NewInt(1)),
+		} {
+			data := d.FillBytes(make([]byte, PrivateKeyCompressedSize))
+
+			_, err := UnmarshalPrivateKey(data)
+			require.ErrorIs(t, err, ErrInvalidPrivateKey, "D = %x", data)
+
+			wif, err := WIFEncode(&ecdsa.PrivateKey{D: d})
+			require.NoError(t, err)
+
+			_, err = WIFDecode(wif)
+			require.ErrorIs(t, err, ErrInvalidPrivateKey, "D = %x", data)
+		}
+	})
+
+	t.Run("public key mismatch", func(t *testing.T) {
+		key := *test.DecodeKey(0)
+		key.PublicKey = test.DecodeKey(1).PublicKey
+
+		require.ErrorIs(t, ValidatePrivateKey(&key), ErrInvalidPrivateKey)
+
+		der, err := x509.MarshalECPrivateKey(&key)
+		require.NoError(t, err)
+
+		_, err = UnmarshalPrivateKey(der)
+		require.ErrorIs(t, err, ErrInvalidPrivateKey)
+	})
+
+	t.Run("empty key", func(t *testing.T) {
+		require.ErrorIs(t, ValidatePrivateKey(nil), ErrEmptyPrivateKey)
+		require.ErrorIs(t, ValidatePrivateKey(&ecdsa.PrivateKey{}), ErrEmptyPrivateKey)
+	})
+}
+
+func TestMarshalPrivateKey_FixedWidth(t *testing.T) {
+	short := func(d *big.Int) *ecdsa.PrivateKey {
+		key := &ecdsa.PrivateKey{D: d}
+		key.Curve = elliptic.P256()
+		key.X, key.Y = key.Curve.ScalarBaseMult(d.Bytes())
+
+		return key
+	}
+
+	keys := []*ecdsa.PrivateKey{
+		short(big.NewInt(1)),
+		short(big.NewInt(0xff)),
+		short(new(big.Int).Lsh(big.NewInt(1), 248)), // 32 bytes
+		short(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 248), big.NewInt(1))),    // 31 bytes
+		short(new(big.Int).Sub(elliptic.P256().Params().N, big.NewInt(1))),              // max
+		short(new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(0x1f))), // 17 bytes
+	}
+
+	for i := 0; i < 256; i++ {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		keys = append(keys, key)
+	}
+
+	for _, key := range keys {
+		data := MarshalPrivateKey(key)
+		require.Len(t, data, PrivateKeyCompressedSize)
+
+		actual, err := UnmarshalPrivateKey(data)
+		require.NoError(t, err, "D = %x", data)
+		require.Equal(t, key.D, actual.D)
+		require.True(t, key.PublicKey.Equal(&actual.PublicKey))
+
+		wif, err := WIFEncode(key)
+		require.NoError(t, err)
+
+		actual, err = WIFDecode(wif)
+		require.NoError(t, err, "D = %x", data)
+		require.Equal(t, key.D, actual.D)
+
+		for _, format := range []KeyFormat{FormatRaw, FormatHex, FormatWIF, FormatDER, FormatPEM, FormatMnemonic} {
+			encoded, err := EncodePrivateKey(key, format)
+			require.NoError(t, err)
+
+			actual, err = DecodePrivateKey(encoded)
+			require.NoError(t, err, "D = %x, format = %s", data, format)
+			require.Equal(t, key.D, actual.D)
+		}
+	}
+}
+
+func TestMarshalPrivateKey_WideD(t *testing.T) {
+	for _, d := range []*big.Int{
+		new(big.Int).Lsh(big.NewInt(1), 256),
+		new(big.Int).Lsh(big.NewInt(1), 300),
+		big.NewInt(-1),
+	} {
+		key := &ecdsa.PrivateKey{D: d}
+		key.Curve = elliptic.P256()
+
+		require.Nil(t, MarshalPrivateKey(key), d)
+
+		_, err := WIFEncode(key)
+		require.ErrorIs(t, err, ErrInvalidPrivateKey, d)
+
+		_, err = MnemonicEncode(key)
+		require.ErrorIs(t, err, ErrInvalidPrivateKey, d)
+
+		_, err = NewSecretKey(key)
+		require.ErrorIs(t, err, ErrInvalidPrivateKey, d)
+
+		_, err = EncodePrivateKey(key, FormatRaw)
+		require.ErrorIs(t, err, ErrInvalidPrivateKey, d)
+	}
+}
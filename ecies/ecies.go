@@ -0,0 +1,140 @@
+/*
+Package ecies implements public-key encryption of small payloads to P-256
+keys. Every message is encrypted with a fresh ephemeral key: ECDH between
+ephemeral and recipient keys followed by HKDF-SHA256 gives one-time
+AES-256-GCM key.
+
+Ciphertext format (version 1):
+
+	version (1 byte) || ephemeral public key (33 bytes, compressed) || AES-GCM output
+
+Header (version and ephemeral key) is authenticated together with caller
+provided additional data.
+*/
+package ecies
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"fmt"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/internal"
+)
+
+const (
+	// Version is the current version of ciphertext format.
+	Version = 1
+
+	// HeaderSize is the size of ciphertext header.
+	HeaderSize = 1 + crypto.PublicKeyCompressedSize
+
+	// Overhead is the difference between ciphertext and plaintext sizes.
+	Overhead = HeaderSize + tagSize
+
+	tagSize = 16
+
+	// ErrUnsupportedVersion when ciphertext has unknown version.
+	ErrUnsupportedVersion = internal.Error("unsupported ciphertext version")
+
+	// ErrShortCiphertext when ciphertext is shorter than Overhead.
+	ErrShortCiphertext = internal.Error("ciphertext is too short")
+
+	// ErrDecrypt when ciphertext can't be authenticated.
+	ErrDecrypt = internal.Error("could not decrypt")
+)
+
+// kdfInfo is a prefix of HKDF info, it is followed by ephemeral
+// and recipient public keys.
+const kdfInfo = "neofs-crypto ecies v1"
+
+// Encrypt encrypts plaintext to the public key. Additional data aad is
+// authenticated, but not encrypted, the same aad must be passed to Decrypt.
+func Encrypt(pub *ecdsa.PublicKey, plaintext, aad []byte) ([]byte, error) {
+	if pub == nil {
+		return nil, crypto.ErrEmptyPublicKey
+	}
+
+	ephemeral, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	defer crypto.DestroyPrivateKey(ephemeral)
+
+	header := make([]byte, 0, HeaderSize)
+	header = append(header, Version)
+	header = append(header, crypto.MarshalPublicKey(&ephemeral.PublicKey)...)
+
+	aead, err := newAEAD(ephemeral, pub, header[1:], crypto.MarshalPublicKey(pub))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]byte, HeaderSize, len(plaintext)+Overhead)
+	copy(res, header)
+
+	return aead.Seal(res, make([]byte, aead.NonceSize()), plaintext, additionalData(header, aad)), nil
+}
+
+// Decrypt decrypts ciphertext produced by Encrypt using recipient private key.
+func Decrypt(priv *ecdsa.PrivateKey, ciphertext, aad []byte) ([]byte, error) {
+	if priv == nil || priv.D == nil {
+		return nil, crypto.ErrEmptyPrivateKey
+	} else if len(ciphertext) < Overhead {
+		return nil, ErrShortCiphertext
+	} else if ciphertext[0] != Version {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, ciphertext[0])
+	}
+
+	header := ciphertext[:HeaderSize]
+
+	ephemeral := crypto.UnmarshalPublicKey(header[1:])
+	if ephemeral == nil {
+		return nil, fmt.Errorf("%w: bad ephemeral key", ErrDecrypt)
+	}
+
+	aead, err := newAEAD(priv, ephemeral, header[1:], crypto.MarshalPublicKey(&priv.PublicKey))
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := aead.Open(nil, make([]byte, aead.NonceSize()), ciphertext[HeaderSize:], additionalData(header, aad))
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+
+	return plaintext, nil
+}
+
+// newAEAD creates AES-256-GCM with key derived from ECDH between priv and
+// pub. Key is bound to both ephemeral and recipient public keys. It is used
+// only once, so zero nonce is safe.
+func newAEAD(priv *ecdsa.PrivateKey, pub *ecdsa.PublicKey, ephemeral, recipient []byte) (cipher.AEAD, error) {
+	info := make([]byte, 0, len(kdfInfo)+2*crypto.PublicKeyCompressedSize)
+	info = append(info, kdfInfo...)
+	info = append(info, ephemeral...)
+	info = append(info, recipient...)
+
+	key, err := crypto.SharedSecret(priv, pub, info)
+	if err != nil {
+		return nil, err
+	}
+
+	defer internal.WipeBytes(key)
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func additionalData(header, aad []byte) []byte {
+	data := make([]byte, 0, len(header)+len(aad))
+	data = append(data, header...)
+
+	return append(data, aad...)
+}
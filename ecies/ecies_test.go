@@ -0,0 +1,78 @@
+package ecies
+
+import (
+	"testing"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	var (
+		key       = test.DecodeKey(0)
+		plaintext = []byte("bearer token secret")
+		aad       = []byte("container id")
+	)
+
+	ciphertext, err := Encrypt(&key.PublicKey, plaintext, aad)
+	require.NoError(t, err)
+	require.Len(t, ciphertext, len(plaintext)+Overhead)
+	require.EqualValues(t, Version, ciphertext[0])
+
+	actual, err := Decrypt(key, ciphertext, aad)
+	require.NoError(t, err)
+	require.Equal(t, plaintext, actual)
+
+	t.Run("ephemeral keys", func(t *testing.T) {
+		other, err := Encrypt(&key.PublicKey, plaintext, aad)
+		require.NoError(t, err)
+		require.NotEqual(t, ciphertext, other)
+	})
+
+	t.Run("empty plaintext", func(t *testing.T) {
+		ct, err := Encrypt(&key.PublicKey, nil, nil)
+		require.NoError(t, err)
+
+		pt, err := Decrypt(key, ct, nil)
+		require.NoError(t, err)
+		require.Empty(t, pt)
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		_, err := Decrypt(test.DecodeKey(1), ciphertext, aad)
+		require.ErrorIs(t, err, ErrDecrypt)
+	})
+
+	t.Run("wrong aad", func(t *testing.T) {
+		_, err := Decrypt(key, ciphertext, []byte("other"))
+		require.ErrorIs(t, err, ErrDecrypt)
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		for _, i := range []int{1, HeaderSize, len(ciphertext) - 1} {
+			bad := append([]byte(nil), ciphertext...)
+			bad[i] ^= 1
+
+			_, err := Decrypt(key, bad, aad)
+			require.ErrorIs(t, err, ErrDecrypt, "byte %d", i)
+		}
+	})
+
+	t.Run("bad format", func(t *testing.T) {
+		_, err := Decrypt(key, ciphertext[:Overhead-1], aad)
+		require.ErrorIs(t, err, ErrShortCiphertext)
+
+		bad := append([]byte{Version + 1}, ciphertext[1:]...)
+		_, err = Decrypt(key, bad, aad)
+		require.ErrorIs(t, err, ErrUnsupportedVersion)
+	})
+
+	t.Run("empty keys", func(t *testing.T) {
+		_, err := Encrypt(nil, plaintext, aad)
+		require.ErrorIs(t, err, crypto.ErrEmptyPublicKey)
+
+		_, err = Decrypt(nil, ciphertext, aad)
+		require.ErrorIs(t, err, crypto.ErrEmptyPrivateKey)
+	})
+}
@@ -0,0 +1,219 @@
+/*
+Package envelope implements multi-recipient wrapping of a data key. It is
+used when content encrypted by one key (e.g. NeoFS container key) must be
+accessible to several owners.
+
+Data key is wrapped once per recipient by ecies.Encrypt with recipient key
+ID as additional data. Recipients are identified by SHA-256 hash of
+compressed public key.
+
+Envelope format (version 1):
+
+	version (1 byte)
+	number of recipients (2 bytes, big-endian)
+	recipients: key ID (32 bytes) || ephemeral public key (33 bytes) || wrapped key (48 bytes)
+
+Ephemeral public key and wrapped key form ECIES version 1 ciphertext without
+the version byte.
+*/
+package envelope
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/ecies"
+	"github.com/nspcc-dev/neofs-crypto/internal"
+)
+
+const (
+	// Version is the current version of envelope format.
+	Version = 1
+
+	// DataKeySize is the size of wrapped data key.
+	DataKeySize = 32
+
+	// KeyIDSize is the size of recipient identifier.
+	KeyIDSize = sha256.Size
+
+	// WrappedKeySize is the size of wrapped data key.
+	WrappedKeySize = DataKeySize + ecies.Overhead - ecies.HeaderSize
+
+	// RecipientSize is the size of encoded recipient.
+	RecipientSize = KeyIDSize + crypto.PublicKeyCompressedSize + WrappedKeySize
+
+	// MaxRecipients is the maximal number of envelope recipients.
+	MaxRecipients = 0xffff
+
+	headerSize = 1 + 2
+
+	// eciesVersion is the version of ECIES ciphertexts of recipients.
+	eciesVersion = 1
+
+	// ErrNoRecipients when envelope is created without recipients.
+	ErrNoRecipients = internal.Error("no recipients")
+
+	// ErrNotRecipient when private key can't unwrap data key.
+	ErrNotRecipient = internal.Error("not an envelope recipient")
+
+	// ErrBadDataKey when data key has wrong size.
+	ErrBadDataKey = internal.Error("bad data key size")
+
+	// ErrBadEnvelope when envelope can't be decoded.
+	ErrBadEnvelope = internal.Error("bad envelope")
+)
+
+// KeyID identifies recipient by SHA-256 hash of compressed public key.
+type KeyID [KeyIDSize]byte
+
+// Recipient is a data key wrapped for one recipient.
+type Recipient struct {
+	ID         KeyID
+	Ephemeral  [crypto.PublicKeyCompressedSize]byte
+	WrappedKey [WrappedKeySize]byte
+}
+
+// Envelope holds data key wrapped for several recipients.
+type Envelope struct {
+	Recipients []Recipient
+}
+
+// NewKeyID returns identifier of the public key.
+func NewKeyID(pub *ecdsa.PublicKey) KeyID {
+	return sha256.Sum256(crypto.MarshalPublicKey(pub))
+}
+
+// New generates random data key and wraps it for recipients.
+func New(recipients ...*ecdsa.PublicKey) ([]byte, *Envelope, error) {
+	dataKey := make([]byte, DataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, nil, err
+	}
+
+	env, err := Seal(dataKey, recipients...)
+	if err != nil {
+		internal.WipeBytes(dataKey)
+		return nil, nil, err
+	}
+
+	return dataKey, env, nil
+}
+
+// Seal wraps the given data key for recipients.
+func Seal(dataKey []byte, recipients ...*ecdsa.PublicKey) (*Envelope, error) {
+	if ln := len(dataKey); ln != DataKeySize {
+		return nil, fmt.Errorf("%w: actual=%d, expect=%d", ErrBadDataKey, ln, DataKeySize)
+	} else if len(recipients) == 0 {
+		return nil, ErrNoRecipients
+	} else if len(recipients) > MaxRecipients {
+		return nil, fmt.Errorf("%w: %d recipients, max %d", ErrBadEnvelope, len(recipients), MaxRecipients)
+	}
+
+	env := &Envelope{Recipients: make([]Recipient, len(recipients))}
+
+	for i, pub := range recipients {
+		if err := env.Recipients[i].wrap(dataKey, pub); err != nil {
+			return nil, err
+		}
+	}
+
+	return env, nil
+}
+
+func (r *Recipient) wrap(dataKey []byte, pub *ecdsa.PublicKey) error {
+	recipientPub := crypto.MarshalPublicKey(pub)
+	if recipientPub == nil {
+		return crypto.ErrEmptyPublicKey
+	}
+
+	r.ID = sha256.Sum256(recipientPub)
+
+	ct, err := ecies.Encrypt(pub, dataKey, r.ID[:])
+	if err != nil {
+		return err
+	} else if ct[0] != eciesVersion {
+		return fmt.Errorf("%w: %d", ecies.ErrUnsupportedVersion, ct[0])
+	}
+
+	copy(r.Ephemeral[:], ct[1:ecies.HeaderSize])
+	copy(r.WrappedKey[:], ct[ecies.HeaderSize:])
+
+	return nil
+}
+
+// ciphertext returns ECIES ciphertext of the wrapped key.
+func (r *Recipient) ciphertext() []byte {
+	ct := make([]byte, 0, ecies.HeaderSize+WrappedKeySize)
+	ct = append(ct, eciesVersion)
+	ct = append(ct, r.Ephemeral[:]...)
+
+	return append(ct, r.WrappedKey[:]...)
+}
+
+// Open unwraps data key using recipient private key.
+func (e *Envelope) Open(priv *ecdsa.PrivateKey) ([]byte, error) {
+	if priv == nil || priv.D == nil {
+		return nil, crypto.ErrEmptyPrivateKey
+	}
+
+	id := NewKeyID(&priv.PublicKey)
+
+	for i := range e.Recipients {
+		r := &e.Recipients[i]
+		if r.ID != id {
+			continue
+		}
+
+		if dataKey, err := ecies.Decrypt(priv, r.ciphertext(), r.ID[:]); err == nil {
+			return dataKey, nil
+		}
+	}
+
+	return nil, ErrNotRecipient
+}
+
+// Bytes encodes envelope.
+func (e *Envelope) Bytes() []byte {
+	data := make([]byte, 0, headerSize+len(e.Recipients)*RecipientSize)
+	data = append(data, Version)
+	data = binary.BigEndian.AppendUint16(data, uint16(len(e.Recipients)))
+
+	for i := range e.Recipients {
+		data = append(data, e.Recipients[i].ID[:]...)
+		data = append(data, e.Recipients[i].Ephemeral[:]...)
+		data = append(data, e.Recipients[i].WrappedKey[:]...)
+	}
+
+	return data
+}
+
+// Decode decodes envelope produced by Envelope.Bytes.
+func Decode(data []byte) (*Envelope, error) {
+	if len(data) < headerSize {
+		return nil, fmt.Errorf("%w: too short", ErrBadEnvelope)
+	} else if data[0] != Version {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrBadEnvelope, data[0])
+	}
+
+	count := int(binary.BigEndian.Uint16(data[1:headerSize]))
+	if count == 0 {
+		return nil, fmt.Errorf("%w: %w", ErrBadEnvelope, ErrNoRecipients)
+	} else if actual, expect := len(data), headerSize+count*RecipientSize; actual != expect {
+		return nil, fmt.Errorf("%w: actual=%d, expect=%d", ErrBadEnvelope, actual, expect)
+	}
+
+	env := &Envelope{Recipients: make([]Recipient, count)}
+
+	for i, off := 0, headerSize; i < count; i, off = i+1, off+RecipientSize {
+		r := &env.Recipients[i]
+		copy(r.ID[:], data[off:])
+		copy(r.Ephemeral[:], data[off+KeyIDSize:])
+		copy(r.WrappedKey[:], data[off+KeyIDSize+crypto.PublicKeyCompressedSize:])
+	}
+
+	return env, nil
+}
@@ -0,0 +1,114 @@
+package envelope
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/ecies"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvelope(t *testing.T) {
+	owners := []*ecdsa.PublicKey{
+		&test.DecodeKey(1).PublicKey,
+		&test.DecodeKey(2).PublicKey,
+		&test.DecodeKey(3).PublicKey,
+	}
+
+	dataKey, env, err := New(owners...)
+	require.NoError(t, err)
+	require.Len(t, dataKey, DataKeySize)
+	require.Len(t, env.Recipients, len(owners))
+
+	decoded, err := Decode(env.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, env, decoded)
+
+	for i := 1; i <= 3; i++ {
+		require.Equal(t, NewKeyID(&test.DecodeKey(i).PublicKey), decoded.Recipients[i-1].ID)
+
+		actual, err := decoded.Open(test.DecodeKey(i))
+		require.NoError(t, err)
+		require.Equal(t, dataKey, actual)
+	}
+
+	t.Run("not a recipient", func(t *testing.T) {
+		_, err := env.Open(test.DecodeKey(4))
+		require.ErrorIs(t, err, ErrNotRecipient)
+
+		_, err = env.Open(nil)
+		require.ErrorIs(t, err, crypto.ErrEmptyPrivateKey)
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		data := env.Bytes()
+		data[len(data)-1] ^= 1
+
+		bad, err := Decode(data)
+		require.NoError(t, err)
+
+		_, err = bad.Open(test.DecodeKey(3))
+		require.ErrorIs(t, err, ErrNotRecipient)
+
+		// recipient ID is authenticated too
+		bad, err = Decode(env.Bytes())
+		require.NoError(t, err)
+		bad.Recipients[0].ID = bad.Recipients[1].ID
+
+		_, err = bad.Open(test.DecodeKey(2))
+		require.NoError(t, err) // real entry of key 2 still works
+
+		bad.Recipients[1].ID = KeyID{}
+		_, err = bad.Open(test.DecodeKey(2))
+		require.ErrorIs(t, err, ErrNotRecipient)
+	})
+
+	t.Run("ecies", func(t *testing.T) {
+		r := env.Recipients[0]
+
+		actual, err := ecies.Decrypt(test.DecodeKey(1), r.ciphertext(), r.ID[:])
+		require.NoError(t, err)
+		require.Equal(t, dataKey, actual)
+
+		_, err = ecies.Decrypt(test.DecodeKey(1), r.ciphertext(), nil)
+		require.ErrorIs(t, err, ecies.ErrDecrypt)
+	})
+
+	t.Run("seal given key", func(t *testing.T) {
+		env, err := Seal(dataKey, owners[0])
+		require.NoError(t, err)
+
+		actual, err := env.Open(test.DecodeKey(1))
+		require.NoError(t, err)
+		require.Equal(t, dataKey, actual)
+	})
+
+	t.Run("bad arguments", func(t *testing.T) {
+		_, err := Seal(dataKey[1:], owners...)
+		require.ErrorIs(t, err, ErrBadDataKey)
+
+		_, _, err = New()
+		require.ErrorIs(t, err, ErrNoRecipients)
+
+		_, _, err = New(nil)
+		require.ErrorIs(t, err, crypto.ErrEmptyPublicKey)
+	})
+
+	t.Run("bad encoding", func(t *testing.T) {
+		data := env.Bytes()
+
+		_, err := Decode(data[:len(data)-1])
+		require.ErrorIs(t, err, ErrBadEnvelope)
+
+		_, err = Decode([]byte{Version, 0, 0})
+		require.ErrorIs(t, err, ErrBadEnvelope)
+
+		_, err = Decode(append([]byte{Version + 1}, data[1:]...))
+		require.ErrorIs(t, err, ErrBadEnvelope)
+
+		_, err = Decode(nil)
+		require.ErrorIs(t, err, ErrBadEnvelope)
+	})
+}
@@ -0,0 +1,165 @@
+package crypto
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"math/big"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/nspcc-dev/neofs-crypto/internal"
+)
+
+const (
+	// ErrBadVanityPrefix when passed address prefix can never be matched.
+	ErrBadVanityPrefix = internal.Error("bad vanity prefix")
+
+	// maxGenerateAttempts limits rejection sampling in GenerateKeyFrom.
+	// Probability of a random 256-bit value to be out of [1, N) is about 2^-32.
+	maxGenerateAttempts = 100
+
+	// base58Alphabet is the alphabet used by Neo addresses.
+	base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+	// addressLength is the length of any Neo N3 address.
+	addressLength = 34
+
+	// addressPayloadBits is the size of address data following version byte:
+	// script hash and checksum.
+	addressPayloadBits = (ScriptHashSize + 4) * 8
+)
+
+// GenerateKey generates new P-256 private key using crypto/rand.
+func GenerateKey() (*ecdsa.PrivateKey, error) {
+	return GenerateKeyFrom(rand.Reader)
+}
+
+// GenerateKeyFrom generates new P-256 private key reading entropy from r.
+// Unlike ecdsa.GenerateKey, result is fully determined by r contents, so
+// it can be used with custom entropy sources. D is sampled uniformly by
+// rejecting values out of [1, N) range.
+func GenerateKeyFrom(r io.Reader) (*ecdsa.PrivateKey, error) {
+	buf := make([]byte, PrivateKeyCompressedSize)
+	defer internal.WipeBytes(buf)
+
+	n := curve.Params().N
+
+	for i := 0; i < maxGenerateAttempts; i++ {
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, fmt.Errorf("could not read entropy: %w", err)
+		}
+
+		if d := new(big.Int).SetBytes(buf); d.Sign() > 0 && d.Cmp(n) < 0 {
+			key := &ecdsa.PrivateKey{D: d}
+			key.Curve = curve
+			key.X, key.Y = curve.ScalarBaseMult(buf)
+
+			return key, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not generate key in %d attempts, check entropy source",
+		maxGenerateAttempts)
+}
+
+// GenerateVanityKey searches for a private key whose Neo address starts with
+// the given prefix using all available cores. Every Neo N3 address starts
+// with 'N', so the prefix must start with it too. Search is stopped when ctx
+// is done.
+func GenerateVanityKey(ctx context.Context, prefix string) (*ecdsa.PrivateKey, error) {
+	return GenerateVanityKeyN(ctx, prefix, runtime.NumCPU())
+}
+
+// GenerateVanityKeyN is the same as GenerateVanityKey, but uses the given
+// number of workers.
+func GenerateVanityKeyN(ctx context.Context, prefix string, workers int) (*ecdsa.PrivateKey, error) {
+	if err := checkVanityPrefix(prefix); err != nil {
+		return nil, err
+	} else if workers < 1 {
+		workers = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg     sync.WaitGroup
+		once   sync.Once
+		result *ecdsa.PrivateKey
+		resErr error
+	)
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for ctx.Err() == nil {
+				key, err := GenerateKey()
+				if err == nil && !strings.HasPrefix(PublicKeyAddress(&key.PublicKey), prefix) {
+					DestroyPrivateKey(key)
+					continue
+				}
+
+				once.Do(func() {
+					result, resErr = key, err
+					cancel()
+				})
+
+				return
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if result == nil && resErr == nil {
+		resErr = ctx.Err()
+	}
+
+	return result, resErr
+}
+
+func checkVanityPrefix(prefix string) error {
+	if !strings.HasPrefix(prefix, "N") {
+		return fmt.Errorf("%w: %q, Neo address always starts with 'N'", ErrBadVanityPrefix, prefix)
+	} else if len(prefix) > addressLength {
+		return fmt.Errorf("%w: %q, Neo address has %d symbols", ErrBadVanityPrefix, prefix, addressLength)
+	}
+
+	// all addresses have the same length, so prefix matches addresses in
+	// [prefix * 58^rest, (prefix+1) * 58^rest) range
+	var (
+		radix = big.NewInt(int64(len(base58Alphabet)))
+		lo    = new(big.Int)
+	)
+
+	for _, c := range prefix {
+		i := strings.IndexRune(base58Alphabet, c)
+		if i < 0 {
+			return fmt.Errorf("%w: %q, symbol %q is not in base58 alphabet", ErrBadVanityPrefix, prefix, c)
+		}
+
+		lo.Mul(lo, radix).Add(lo, big.NewInt(int64(i)))
+	}
+
+	scale := new(big.Int).Exp(radix, big.NewInt(int64(addressLength-len(prefix))), nil)
+	hi := new(big.Int).Add(lo, big.NewInt(1))
+	lo.Mul(lo, scale)
+	hi.Mul(hi, scale)
+
+	// addresses encode 25 bytes starting with AddressVersion
+	minAddr := new(big.Int).Lsh(big.NewInt(AddressVersion), addressPayloadBits)
+	maxAddr := new(big.Int).Lsh(big.NewInt(AddressVersion+1), addressPayloadBits)
+
+	if hi.Cmp(minAddr) <= 0 || lo.Cmp(maxAddr) >= 0 {
+		return fmt.Errorf("%w: %q, no Neo address can start with it", ErrBadVanityPrefix, prefix)
+	}
+
+	return nil
+}
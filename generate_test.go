@@ -0,0 +1,115 @@
+package crypto
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateKey(t *testing.T) {
+	t.Run("random", func(t *testing.T) {
+		for i := 0; i < 16; i++ {
+			key, err := GenerateKey()
+			require.NoError(t, err)
+			require.NoError(t, ValidatePrivateKey(key))
+
+			actual, err := UnmarshalPrivateKey(MarshalPrivateKey(key))
+			require.NoError(t, err)
+			require.Equal(t, key, actual)
+
+			wif, err := WIFEncode(key)
+			require.NoError(t, err)
+
+			actual, err = WIFDecode(wif)
+			require.NoError(t, err)
+			require.Equal(t, key, actual)
+		}
+	})
+
+	t.Run("deterministic", func(t *testing.T) {
+		seed := make([]byte, PrivateKeyCompressedSize)
+		_, err := rand.Read(seed)
+		require.NoError(t, err)
+
+		k1, err := GenerateKeyFrom(bytes.NewReader(seed))
+		require.NoError(t, err)
+
+		k2, err := GenerateKeyFrom(bytes.NewReader(seed))
+		require.NoError(t, err)
+
+		require.Equal(t, k1, k2)
+	})
+
+	t.Run("rejects out of range values", func(t *testing.T) {
+		var (
+			zero = make([]byte, PrivateKeyCompressedSize)
+			max  = bytes.Repeat([]byte{0xff}, PrivateKeyCompressedSize)
+			one  = append(make([]byte, PrivateKeyCompressedSize-1), 1)
+		)
+
+		key, err := GenerateKeyFrom(io.MultiReader(
+			bytes.NewReader(zero),
+			bytes.NewReader(max),
+			bytes.NewReader(one)))
+		require.NoError(t, err)
+		require.EqualValues(t, 1, key.D.Int64())
+		require.NoError(t, ValidatePrivateKey(key))
+	})
+
+	t.Run("broken entropy source", func(t *testing.T) {
+		_, err := GenerateKeyFrom(bytes.NewReader([]byte{1, 2, 3}))
+		require.Error(t, err)
+
+		_, err = GenerateKeyFrom(bytes.NewReader(make([]byte, 1<<16)))
+		require.Error(t, err)
+	})
+}
+
+func TestGenerateVanityKey(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	key, err := GenerateVanityKey(ctx, "Na")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(PublicKeyAddress(&key.PublicKey), "Na"))
+	require.NoError(t, ValidatePrivateKey(key))
+
+	t.Run("bad prefix", func(t *testing.T) {
+		_, err := GenerateVanityKey(ctx, "Aa")
+		require.ErrorIs(t, err, ErrBadVanityPrefix)
+
+		_, err = GenerateVanityKey(ctx, "N0")
+		require.ErrorIs(t, err, ErrBadVanityPrefix)
+
+		// second symbol of any N3 address is in K..j range
+		for _, prefix := range []string{
+			"N1", "NA", "NJ", "Nk", "Nz", "NzzzzzzzzZ", "NKux", "NjFb",
+			"NKuyBkoGdZZSLyPbJEetheRhMjezgQv9mG", "NjFaAs6ZLk2KAQXgKezDBmhUzEuwPeVz5d",
+			strings.Repeat("N", 35),
+		} {
+			_, err = GenerateVanityKey(ctx, prefix)
+			require.ErrorIs(t, err, ErrBadVanityPrefix, prefix)
+		}
+
+		for _, prefix := range []string{
+			"N", "NK", "Nj", "NeoFS", "NKuy", "NjFa", "NTQLXHMkgwA77wpaNUNaX3NMjEs9ioM8GZ",
+			"NKuyBkoGdZZSLyPbJEetheRhMjezgQv9mH", "NjFaAs6ZLk2KAQXgKezDBmhUzEuwPeVz5c",
+		} {
+			require.NoError(t, checkVanityPrefix(prefix), prefix)
+		}
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := GenerateVanityKeyN(ctx, "NeoFSNeoFS", 2)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
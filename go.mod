@@ -6,6 +6,9 @@ require (
 	github.com/mr-tron/base58 v1.2.0
 	github.com/nspcc-dev/rfc6979 v0.2.1
 	github.com/stretchr/testify v1.8.4
+	golang.org/x/crypto v0.24.0
+	golang.org/x/sys v0.21.0
+	golang.org/x/text v0.16.0
 )
 
 require (
@@ -0,0 +1,419 @@
+/*
+Package hd implements hierarchical deterministic key derivation for P-256
+keys. It follows BIP32 semantics adapted to secp256r1 curve as defined by
+SLIP-0010, produced keys are compatible with crypto.MarshalPrivateKey and
+crypto.WIFEncode.
+*/
+package hd
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/mr-tron/base58"
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/internal"
+	"github.com/nspcc-dev/neofs-crypto/mnemonic"
+)
+
+const (
+	// HardenedKeyStart is the index of the first hardened child key.
+	HardenedKeyStart uint32 = 0x80000000
+
+	// MinSeedSize is the minimal size of master seed in bytes.
+	MinSeedSize = 16
+
+	// MaxSeedSize is the maximal size of master seed in bytes.
+	MaxSeedSize = 64
+
+	// ExtendedKeySize is the size of serialized extended key without checksum.
+	ExtendedKeySize = 78
+
+	// ErrBadSeed when passed seed has wrong length.
+	ErrBadSeed = internal.Error("bad seed length")
+
+	// ErrBadPath when derivation path can't be parsed.
+	ErrBadPath = internal.Error("bad derivation path")
+
+	// ErrHardenedFromPublic when hardened child is derived from public key.
+	ErrHardenedFromPublic = internal.Error("can't derive hardened child from public key")
+
+	// ErrMaxDepth when derivation exceeds 255 levels.
+	ErrMaxDepth = internal.Error("max derivation depth reached")
+
+	// ErrBadExtendedKey when serialized extended key can't be decoded.
+	ErrBadExtendedKey = internal.Error("bad extended key")
+
+	// ErrNotPrivate when private key is requested from public extended key.
+	ErrNotPrivate = internal.Error("extended key is not private")
+)
+
+var (
+	// VersionPrivate is a version prefix of serialized private extended keys (xprv).
+	VersionPrivate = [4]byte{0x04, 0x88, 0xad, 0xe4}
+
+	// VersionPublic is a version prefix of serialized public extended keys (xpub).
+	VersionPublic = [4]byte{0x04, 0x88, 0xb2, 0x1e}
+
+	// masterKeyHMAC is the HMAC key for master key generation defined by SLIP-0010.
+	masterKeyHMAC = []byte("Nist256p1 seed")
+
+	curve = elliptic.P256()
+)
+
+// ExtendedKey is a private or public key with chain code and its position
+// in the derivation tree.
+type ExtendedKey struct {
+	// d is D of the private key, it is empty for public extended keys.
+	d [crypto.PrivateKeyCompressedSize]byte
+	// pub is compressed public key.
+	pub [crypto.PublicKeyCompressedSize]byte
+
+	chainCode   [32]byte
+	parentFP    [4]byte
+	childNumber uint32
+	depth       uint8
+	private     bool
+}
+
+// NewMaster creates master extended key from the seed.
+func NewMaster(seed []byte) (*ExtendedKey, error) {
+	if ln := len(seed); ln < MinSeedSize || ln > MaxSeedSize {
+		return nil, fmt.Errorf("%w: actual=%d, expect=[%d, %d]", ErrBadSeed, ln, MinSeedSize, MaxSeedSize)
+	}
+
+	i := hmacSHA512(masterKeyHMAC, seed)
+	for {
+		if d := new(big.Int).SetBytes(i[:32]); d.Sign() > 0 && d.Cmp(curve.Params().N) < 0 {
+			break
+		}
+
+		i = hmacSHA512(masterKeyHMAC, i)
+	}
+
+	return newPrivate(i[:32], i[32:], [4]byte{}, 0, 0), nil
+}
+
+// NewMasterFromMnemonic creates master extended key from BIP39 mnemonic
+// phrase and optional passphrase.
+func NewMasterFromMnemonic(phrase, passphrase string) (*ExtendedKey, error) {
+	seed, err := mnemonic.Seed(phrase, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	defer internal.WipeBytes(seed)
+
+	return NewMaster(seed)
+}
+
+func newPrivate(d, chainCode []byte, parentFP [4]byte, child uint32, depth uint8) *ExtendedKey {
+	k := &ExtendedKey{
+		parentFP:    parentFP,
+		childNumber: child,
+		depth:       depth,
+		private:     true,
+	}
+
+	copy(k.d[:], d)
+	copy(k.chainCode[:], chainCode)
+	x, y := curve.ScalarBaseMult(k.d[:])
+	copy(k.pub[:], elliptic.MarshalCompressed(curve, x, y))
+
+	return k
+}
+
+// Child derives child extended key with the given index. Indexes starting
+// from HardenedKeyStart produce hardened keys which can be derived only
+// from private extended key.
+func (k *ExtendedKey) Child(index uint32) (*ExtendedKey, error) {
+	if k.depth == 0xff {
+		return nil, ErrMaxDepth
+	}
+
+	hardened := index >= HardenedKeyStart
+	if hardened && !k.private {
+		return nil, ErrHardenedFromPublic
+	}
+
+	data := make([]byte, 0, 1+crypto.PublicKeyCompressedSize+4)
+	if hardened {
+		data = append(data, 0)
+		data = append(data, k.d[:]...)
+	} else {
+		data = append(data, k.pub[:]...)
+	}
+
+	data = binary.BigEndian.AppendUint32(data, index)
+	fp := k.Fingerprint()
+
+	for {
+		i := hmacSHA512(k.chainCode[:], data)
+		il, ir := i[:32], i[32:]
+
+		if child, ok := k.childKey(il); ok {
+			child.parentFP = fp
+			child.childNumber = index
+			child.depth = k.depth + 1
+			copy(child.chainCode[:], ir)
+
+			return child, nil
+		}
+
+		// SLIP-0010: on invalid key proceed with I = HMAC(c, 0x01 || IR || ser32(i))
+		data = append(append(data[:0], 1), ir...)
+		data = binary.BigEndian.AppendUint32(data, index)
+	}
+}
+
+// childKey computes child key from the left half of HMAC output. It returns
+// false if result is invalid and derivation must be repeated.
+func (k *ExtendedKey) childKey(il []byte) (*ExtendedKey, bool) {
+	n := curve.Params().N
+
+	t := new(big.Int).SetBytes(il)
+	if t.Cmp(n) >= 0 {
+		return nil, false
+	}
+
+	if k.private {
+		d := t.Add(t, new(big.Int).SetBytes(k.d[:]))
+		d.Mod(d, n)
+
+		if d.Sign() == 0 {
+			return nil, false
+		}
+
+		child := newPrivate(d.FillBytes(make([]byte, 32)), nil, [4]byte{}, 0, 0)
+
+		return child, true
+	}
+
+	px, py := elliptic.UnmarshalCompressed(curve, k.pub[:])
+	tx, ty := curve.ScalarBaseMult(il)
+
+	x, y := curve.Add(tx, ty, px, py)
+	if x.Sign() == 0 && y.Sign() == 0 { // point at infinity
+		return nil, false
+	}
+
+	child := new(ExtendedKey)
+	copy(child.pub[:], elliptic.MarshalCompressed(curve, x, y))
+
+	return child, true
+}
+
+// Derive derives extended key by the path like m/44'/888'/0'/0/0.
+func (k *ExtendedKey) Derive(path string) (*ExtendedKey, error) {
+	indexes, err := ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.DerivePath(indexes)
+}
+
+// DerivePath derives extended key by the list of child indexes.
+func (k *ExtendedKey) DerivePath(indexes []uint32) (*ExtendedKey, error) {
+	var (
+		err error
+		res = k
+	)
+
+	for _, index := range indexes {
+		if res, err = res.Child(index); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// ParsePath parses derivation path like m/44'/888'/0'/0/0 into the list of
+// child indexes. Path starts with m (or M for public derivation), hardened
+// indexes are marked by ', h or H suffix.
+func ParsePath(path string) ([]uint32, error) {
+	parts := strings.Split(path, "/")
+	if parts[0] != "m" && parts[0] != "M" {
+		return nil, fmt.Errorf("%w: %q, must start with m", ErrBadPath, path)
+	}
+
+	res := make([]uint32, 0, len(parts)-1)
+
+	for _, part := range parts[1:] {
+		var offset uint32
+
+		if trimmed := strings.TrimRight(part, "'hH"); len(trimmed) == len(part)-1 {
+			part, offset = trimmed, HardenedKeyStart
+		}
+
+		index, err := strconv.ParseUint(part, 10, 32)
+		if err != nil || uint32(index) >= HardenedKeyStart {
+			return nil, fmt.Errorf("%w: %q, bad index %q", ErrBadPath, path, part)
+		}
+
+		res = append(res, uint32(index)+offset)
+	}
+
+	return res, nil
+}
+
+// Neuter returns public extended key corresponding to k.
+func (k *ExtendedKey) Neuter() *ExtendedKey {
+	res := *k
+	res.d = [crypto.PrivateKeyCompressedSize]byte{}
+	res.private = false
+
+	return &res
+}
+
+// IsPrivate returns true if extended key contains private key.
+func (k *ExtendedKey) IsPrivate() bool {
+	return k.private
+}
+
+// Depth returns the depth of the key in derivation tree, master key has zero depth.
+func (k *ExtendedKey) Depth() uint8 {
+	return k.depth
+}
+
+// ChildNumber returns index which was used to derive the key.
+func (k *ExtendedKey) ChildNumber() uint32 {
+	return k.childNumber
+}
+
+// ChainCode returns chain code of the key.
+func (k *ExtendedKey) ChainCode() []byte {
+	return bytes.Clone(k.chainCode[:])
+}
+
+// Fingerprint returns the first 4 bytes of public key HASH160.
+func (k *ExtendedKey) Fingerprint() [4]byte {
+	var fp [4]byte
+
+	h := crypto.ScriptHash(k.pub[:])
+	copy(fp[:], h[:])
+
+	return fp
+}
+
+// PrivateKey returns private key of the extended key.
+func (k *ExtendedKey) PrivateKey() (*ecdsa.PrivateKey, error) {
+	if !k.private {
+		return nil, ErrNotPrivate
+	}
+
+	return crypto.UnmarshalPrivateKey(k.d[:])
+}
+
+// PublicKey returns public key of the extended key.
+func (k *ExtendedKey) PublicKey() *ecdsa.PublicKey {
+	return crypto.UnmarshalPublicKey(k.pub[:])
+}
+
+// Destroy wipes private part of the extended key.
+func (k *ExtendedKey) Destroy() {
+	k.d = [crypto.PrivateKeyCompressedSize]byte{}
+	k.chainCode = [32]byte{}
+	k.private = false
+}
+
+// Bytes returns BIP32 serialization of the extended key without checksum.
+func (k *ExtendedKey) Bytes() []byte {
+	data := make([]byte, 0, ExtendedKeySize)
+
+	if k.private {
+		data = append(data, VersionPrivate[:]...)
+	} else {
+		data = append(data, VersionPublic[:]...)
+	}
+
+	data = append(data, k.depth)
+	data = append(data, k.parentFP[:]...)
+	data = binary.BigEndian.AppendUint32(data, k.childNumber)
+	data = append(data, k.chainCode[:]...)
+
+	if k.private {
+		data = append(data, 0)
+		data = append(data, k.d[:]...)
+	} else {
+		data = append(data, k.pub[:]...)
+	}
+
+	return data
+}
+
+// String returns base58check encoded BIP32 serialization of the extended key.
+func (k *ExtendedKey) String() string {
+	data := k.Bytes()
+	return base58.Encode(append(data, internal.Checksum(data)...))
+}
+
+// ParseExtendedKey decodes extended key from base58check string produced
+// by ExtendedKey.String.
+func ParseExtendedKey(s string) (*ExtendedKey, error) {
+	data, err := base58.Decode(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBadExtendedKey, err)
+	} else if ln := len(data); ln != ExtendedKeySize+4 {
+		return nil, fmt.Errorf("%w: expect: %d, actual: %d", ErrBadExtendedKey, ExtendedKeySize+4, ln)
+	} else if !bytes.Equal(internal.Checksum(data[:ExtendedKeySize]), data[ExtendedKeySize:]) {
+		return nil, fmt.Errorf("%w: bad checksum", ErrBadExtendedKey)
+	}
+
+	k := &ExtendedKey{
+		depth:       data[4],
+		childNumber: binary.BigEndian.Uint32(data[9:13]),
+	}
+
+	copy(k.parentFP[:], data[5:9])
+	copy(k.chainCode[:], data[13:45])
+
+	var version [4]byte
+	copy(version[:], data[:4])
+
+	switch key := data[45:ExtendedKeySize]; version {
+	case VersionPrivate:
+		if key[0] != 0 {
+			return nil, fmt.Errorf("%w: bad private key prefix", ErrBadExtendedKey)
+		}
+
+		priv, err := crypto.UnmarshalPrivateKey(key[1:])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrBadExtendedKey, err)
+		}
+
+		copy(k.d[:], key[1:])
+		copy(k.pub[:], crypto.MarshalPublicKey(&priv.PublicKey))
+		k.private = true
+	case VersionPublic:
+		if crypto.UnmarshalPublicKey(key) == nil || len(key) != crypto.PublicKeyCompressedSize {
+			return nil, fmt.Errorf("%w: bad public key", ErrBadExtendedKey)
+		}
+
+		copy(k.pub[:], key)
+	default:
+		return nil, fmt.Errorf("%w: unknown version %x", ErrBadExtendedKey, version)
+	}
+
+	if k.depth == 0 && (k.parentFP != [4]byte{} || k.childNumber != 0) {
+		return nil, fmt.Errorf("%w: zero depth with non-zero parent", ErrBadExtendedKey)
+	}
+
+	return k, nil
+}
+
+func hmacSHA512(key, data []byte) []byte {
+	h := hmac.New(sha512.New, key)
+	_, _ = h.Write(data)
+
+	return h.Sum(nil)
+}
@@ -0,0 +1,179 @@
+package hd
+
+import (
+	"encoding/hex"
+	"testing"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/mnemonic"
+	"github.com/stretchr/testify/require"
+)
+
+// SLIP-0010 test vector 1 for nist256p1.
+var slip10Vectors = []struct {
+	path      string
+	fp        string
+	chainCode string
+	private   string
+	public    string
+}{
+	{
+		path:      "m",
+		fp:        "00000000",
+		chainCode: "beeb672fe4621673f722f38529c07392fecaa61015c80c34f29ce8b41b3cb6ea",
+		private:   "612091aaa12e22dd2abef664f8a01a82cae99ad7441b7ef8110424915c268bc2",
+		public:    "0266874dc6ade47b3ecd096745ca09bcd29638dd52c2c12117b11ed3e458cfa9e8",
+	},
+	{
+		path:      "m/0'",
+		fp:        "be6105b5",
+		chainCode: "3460cea53e6a6bb5fb391eeef3237ffd8724bf0a40e94943c98b83825342ee11",
+		private:   "6939694369114c67917a182c59ddb8cafc3004e63ca5d3b84403ba8613debc0c",
+		public:    "0384610f5ecffe8fda089363a41f56a5c7ffc1d81b59a612d0d649b2d22355590c",
+	},
+	{
+		path:      "m/0'/1",
+		fp:        "9b02312f",
+		chainCode: "4187afff1aafa8445010097fb99d23aee9f599450c7bd140b6826ac22ba21d0c",
+		private:   "284e9d38d07d21e4e281b645089a94f4cf5a5a81369acf151a1c3a57f18b2129",
+		public:    "03526c63f8d0b4bbbf9c80df553fe66742df4676b241dabefdef67733e070f6844",
+	},
+	{
+		path:      "m/0'/1/2'/2/1000000000",
+		fp:        "8b2b5c4b",
+		chainCode: "b9b7b82d326bb9cb5b5b121066feea4eb93d5241103c9e7a18aad40f1dde8059",
+		private:   "21c4f269ef0a5fd1badf47eeacebeeaa3de22eb8e5b0adcd0f27dd99d34d0119",
+		public:    "02216cd26d31147f72427a453c443ed2cde8a1e53c9cc44e5ddf739725413fe3f4",
+	},
+}
+
+func testMaster(t *testing.T) *ExtendedKey {
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+
+	master, err := NewMaster(seed)
+	require.NoError(t, err)
+
+	return master
+}
+
+func TestDerive(t *testing.T) {
+	master := testMaster(t)
+
+	for _, v := range slip10Vectors {
+		t.Run(v.path, func(t *testing.T) {
+			k, err := master.Derive(v.path)
+			require.NoError(t, err)
+
+			require.Equal(t, v.fp, hex.EncodeToString(k.parentFP[:]))
+			require.Equal(t, v.chainCode, hex.EncodeToString(k.ChainCode()))
+			require.Equal(t, v.public, hex.EncodeToString(crypto.MarshalPublicKey(k.PublicKey())))
+
+			priv, err := k.PrivateKey()
+			require.NoError(t, err)
+			require.Equal(t, v.private, hex.EncodeToString(crypto.MarshalPrivateKey(priv)))
+
+			wif, err := crypto.WIFEncode(priv)
+			require.NoError(t, err)
+
+			decoded, err := crypto.WIFDecode(wif)
+			require.NoError(t, err)
+			require.Equal(t, priv, decoded)
+		})
+	}
+}
+
+func TestPublicDerivation(t *testing.T) {
+	parent, err := testMaster(t).Derive("m/44'/888'/0'")
+	require.NoError(t, err)
+
+	fromPrivate, err := parent.Derive("m/0/5")
+	require.NoError(t, err)
+
+	fromPublic, err := parent.Neuter().Derive("M/0/5")
+	require.NoError(t, err)
+
+	require.False(t, fromPublic.IsPrivate())
+	require.Equal(t, fromPrivate.Neuter(), fromPublic)
+	require.Equal(t, fromPrivate.PublicKey(), fromPublic.PublicKey())
+
+	_, err = fromPublic.PrivateKey()
+	require.ErrorIs(t, err, ErrNotPrivate)
+
+	_, err = parent.Neuter().Child(HardenedKeyStart)
+	require.ErrorIs(t, err, ErrHardenedFromPublic)
+}
+
+func TestParsePath(t *testing.T) {
+	indexes, err := ParsePath("m/44'/888'/0'/0/0")
+	require.NoError(t, err)
+	require.Equal(t, []uint32{HardenedKeyStart + 44, HardenedKeyStart + 888, HardenedKeyStart, 0, 0}, indexes)
+
+	indexes, err = ParsePath("m/1h/2H/3")
+	require.NoError(t, err)
+	require.Equal(t, []uint32{HardenedKeyStart + 1, HardenedKeyStart + 2, 3}, indexes)
+
+	indexes, err = ParsePath("m")
+	require.NoError(t, err)
+	require.Empty(t, indexes)
+
+	for _, path := range []string{"", "44'/0", "m/", "m/x", "m/-1", "m/1''", "m/2147483648", "m/1/"} {
+		_, err = ParsePath(path)
+		require.ErrorIs(t, err, ErrBadPath, path)
+	}
+}
+
+func TestExtendedKeyString(t *testing.T) {
+	k, err := testMaster(t).Derive("m/44'/888'/0'/0/0")
+	require.NoError(t, err)
+
+	for _, key := range []*ExtendedKey{k, k.Neuter()} {
+		s := key.String()
+		require.Len(t, key.Bytes(), ExtendedKeySize)
+
+		decoded, err := ParseExtendedKey(s)
+		require.NoError(t, err)
+		require.Equal(t, key, decoded)
+	}
+
+	require.Equal(t, "xprv", k.String()[:4])
+	require.Equal(t, "xpub", k.Neuter().String()[:4])
+
+	t.Run("bad keys", func(t *testing.T) {
+		s := k.String()
+
+		_, err := ParseExtendedKey(s[:len(s)-1] + "1")
+		require.ErrorIs(t, err, ErrBadExtendedKey)
+
+		_, err = ParseExtendedKey("bad_key")
+		require.ErrorIs(t, err, ErrBadExtendedKey)
+
+		_, err = ParseExtendedKey(s[:20])
+		require.ErrorIs(t, err, ErrBadExtendedKey)
+	})
+}
+
+func TestNewMaster(t *testing.T) {
+	_, err := NewMaster(make([]byte, MinSeedSize-1))
+	require.ErrorIs(t, err, ErrBadSeed)
+
+	_, err = NewMaster(make([]byte, MaxSeedSize+1))
+	require.ErrorIs(t, err, ErrBadSeed)
+}
+
+func TestNewMasterFromMnemonic(t *testing.T) {
+	const phrase = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	seed, err := mnemonic.Seed(phrase, "TREZOR")
+	require.NoError(t, err)
+
+	expected, err := NewMaster(seed)
+	require.NoError(t, err)
+
+	actual, err := NewMasterFromMnemonic(phrase, "TREZOR")
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+
+	_, err = NewMasterFromMnemonic("zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo", "")
+	require.ErrorIs(t, err, mnemonic.ErrBadChecksum)
+}
@@ -0,0 +1,15 @@
+package internal
+
+import "crypto/sha256"
+
+// ChecksumSize is the size of Checksum result.
+const ChecksumSize = 4
+
+// Checksum returns first 4 bytes of double SHA-256 of data, as used by
+// WIF, Neo addresses and Base58Check encodings.
+func Checksum(data []byte) []byte {
+	sum := sha256.Sum256(data)
+	sum = sha256.Sum256(sum[:])
+
+	return sum[:ChecksumSize]
+}
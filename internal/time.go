@@ -0,0 +1,33 @@
+package internal
+
+import (
+	"encoding/binary"
+	"time"
+)
+
+// UnixSize is the size of encoded Unix time.
+const UnixSize = 8
+
+// Now returns clock.Now() or local system time if clock is nil.
+func Now(clock interface{ Now() time.Time }) time.Time {
+	if clock == nil {
+		return time.Now()
+	}
+
+	return clock.Now()
+}
+
+// TruncateTime returns UTC time with second precision.
+func TruncateTime(t time.Time) time.Time {
+	return time.Unix(t.Unix(), 0).UTC()
+}
+
+// AppendUnix appends Unix seconds of t as 8-byte big-endian integer.
+func AppendUnix(buf []byte, t time.Time) []byte {
+	return binary.BigEndian.AppendUint64(buf, uint64(t.Unix()))
+}
+
+// DecodeUnix decodes UTC time from 8-byte big-endian Unix seconds.
+func DecodeUnix(data []byte) time.Time {
+	return time.Unix(int64(binary.BigEndian.Uint64(data)), 0).UTC()
+}
@@ -0,0 +1,20 @@
+package internal
+
+import "math/big"
+
+// WipeBytes overwrites buffer with zeros.
+func WipeBytes(buf []byte) {
+	for i := range buf {
+		buf[i] = 0
+	}
+}
+
+// WipeBigInt overwrites words of big.Int with zeros and resets its value.
+func WipeBigInt(n *big.Int) {
+	words := n.Bits()
+	for i := range words {
+		words[i] = 0
+	}
+
+	n.SetInt64(0)
+}
@@ -1,24 +1,63 @@
 package crypto
 
 import (
+	"bytes"
 	"crypto/ecdsa"
 	"encoding/hex"
+	"encoding/pem"
 	"fmt"
 	"os"
+
+	"github.com/nspcc-dev/neofs-crypto/internal"
 )
 
 // LoadPrivateKey allows to load private key from various formats:
 //   - wif string
 //   - hex string
-//   - file path (D-bytes or SEC 1 / ASN.1 DER form).
+//   - 24-word mnemonic phrase
+//   - file path (D-bytes, hex, wif, mnemonic, SEC 1 / ASN.1 DER or PEM form).
 func LoadPrivateKey(val string) (*ecdsa.PrivateKey, error) {
 	if data, err := os.ReadFile(val); err == nil {
-		return UnmarshalPrivateKey(data)
+		defer internal.WipeBytes(data)
+		return DecodePrivateKey(data)
 	} else if data, err = hex.DecodeString(val); err == nil {
+		defer internal.WipeBytes(data)
 		return UnmarshalPrivateKey(data)
 	} else if key, err := WIFDecode(val); err == nil {
 		return key, nil
+	} else if key, err = MnemonicDecode(val); err == nil {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("unknown key format (%q), expect: hex-string, wif, mnemonic or file-path", val)
+}
+
+// DecodePrivateKey decodes private key from file contents written
+// by SavePrivateKey in any of supported formats. It returns ErrBadKeyFile
+// if data matches none of them.
+func DecodePrivateKey(data []byte) (*ecdsa.PrivateKey, error) {
+	if block, _ := pem.Decode(data); block != nil && block.Type == pemPrivateKeyType {
+		defer internal.WipeBytes(block.Bytes)
+		return UnmarshalPrivateKey(block.Bytes)
+	}
+
+	if key, err := UnmarshalPrivateKey(data); err == nil {
+		return key, nil
+	}
+
+	text := bytes.TrimSpace(data)
+	raw := make([]byte, hex.DecodedLen(len(text)))
+	defer internal.WipeBytes(raw)
+
+	if _, err := hex.Decode(raw, text); err == nil {
+		if key, err := UnmarshalPrivateKey(raw); err == nil {
+			return key, nil
+		}
+	} else if key, err := WIFDecode(string(text)); err == nil {
+		return key, nil
+	} else if key, err := MnemonicDecode(string(text)); err == nil {
+		return key, nil
 	}
 
-	return nil, fmt.Errorf("unknown key format (%q), expect: hex-string, wif or file-path", val)
+	return nil, ErrBadKeyFile
 }
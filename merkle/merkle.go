@@ -0,0 +1,343 @@
+/*
+Package merkle implements SHA-256 Merkle trees over payload chunks with
+inclusion and range proofs. Tree shape and hashing follow RFC 6962: leaves
+are hashed as SHA-256(0x00 || chunk), nodes as SHA-256(0x01 || left || right),
+and the tree over n leaves is split at the largest power of two less than n.
+
+Root is signed by crypto.SignRFC6979Hash, so a single signature covers any
+number of chunks which can be verified separately with proofs.
+*/
+package merkle
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/internal"
+)
+
+const (
+	// HashSize is the size of tree hashes.
+	HashSize = sha256.Size
+
+	// MaxSize is the maximal number of tree leaves accepted in proofs.
+	MaxSize = 1 << 48
+
+	leafPrefix = 0x00
+	nodePrefix = 0x01
+
+	// ErrBadRange when requested leaves are out of tree.
+	ErrBadRange = internal.Error("bad leaf range")
+
+	// ErrBadProof when proof can't be decoded or doesn't match the tree shape.
+	ErrBadProof = internal.Error("bad proof")
+
+	// ErrRootMismatch when root computed from proof differs from the expected one.
+	ErrRootMismatch = internal.Error("root mismatch")
+
+	// ErrBadChunkSize when chunk size is not positive.
+	ErrBadChunkSize = internal.Error("bad chunk size")
+)
+
+// Hash is a tree node hash.
+type Hash [HashSize]byte
+
+// Tree is a Merkle tree over payload chunks.
+type Tree struct {
+	leaves []Hash
+	root   Hash
+}
+
+// LeafHash returns hash of the chunk as a tree leaf.
+func LeafHash(chunk []byte) Hash {
+	h := sha256.New()
+	h.Write([]byte{leafPrefix})
+	h.Write(chunk)
+
+	var res Hash
+	h.Sum(res[:0])
+
+	return res
+}
+
+func nodeHash(left, right Hash) Hash {
+	var buf [1 + 2*HashSize]byte
+
+	buf[0] = nodePrefix
+	copy(buf[1:], left[:])
+	copy(buf[1+HashSize:], right[:])
+
+	return sha256.Sum256(buf[:])
+}
+
+// New builds the tree over chunks.
+func New(chunks [][]byte) *Tree {
+	leaves := make([]Hash, len(chunks))
+	for i := range chunks {
+		leaves[i] = LeafHash(chunks[i])
+	}
+
+	return NewFromLeaves(leaves)
+}
+
+// NewFromLeaves builds the tree over leaf hashes computed by LeafHash.
+func NewFromLeaves(leaves []Hash) *Tree {
+	t := &Tree{leaves: leaves}
+	t.root = t.subtree(0, len(leaves))
+
+	return t
+}
+
+// NewFromReader splits data read from r into chunks of chunkSize bytes
+// (the last one may be shorter) and builds the tree over them.
+func NewFromReader(r io.Reader, chunkSize int) (*Tree, error) {
+	if chunkSize <= 0 {
+		return nil, fmt.Errorf("%w: %d", ErrBadChunkSize, chunkSize)
+	}
+
+	var (
+		leaves []Hash
+		buf    = make([]byte, chunkSize)
+	)
+
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			leaves = append(leaves, LeafHash(buf[:n]))
+		}
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	return NewFromLeaves(leaves), nil
+}
+
+// Root returns root hash of the tree. Root of empty tree is SHA-256 of
+// empty string.
+func (t *Tree) Root() Hash {
+	return t.root
+}
+
+// Len returns the number of leaves.
+func (t *Tree) Len() int {
+	return len(t.leaves)
+}
+
+func (t *Tree) subtree(lo, hi int) Hash {
+	switch hi - lo {
+	case 0:
+		return sha256.Sum256(nil)
+	case 1:
+		return t.leaves[lo]
+	}
+
+	k := lo + split(hi-lo)
+
+	return nodeHash(t.subtree(lo, k), t.subtree(k, hi))
+}
+
+// split returns the largest power of two less than n (n > 1).
+func split(n int) int {
+	return 1 << (bits.Len(uint(n-1)) - 1)
+}
+
+// Proof proves that leaves [Begin, End) belong to the tree of Size leaves.
+type Proof struct {
+	Size   int
+	Begin  int
+	End    int
+	Hashes []Hash
+}
+
+// Proof returns inclusion proof of a single leaf.
+func (t *Tree) Proof(index int) (*Proof, error) {
+	return t.RangeProof(index, index+1)
+}
+
+// RangeProof returns proof of consecutive leaves [begin, end).
+func (t *Tree) RangeProof(begin, end int) (*Proof, error) {
+	if err := checkRange(len(t.leaves), begin, end); err != nil {
+		return nil, err
+	}
+
+	p := &Proof{Size: len(t.leaves), Begin: begin, End: end}
+	t.collect(p, 0, len(t.leaves))
+
+	return p, nil
+}
+
+// collect appends hashes of subtrees of [lo, hi) disjoint with proven range.
+func (t *Tree) collect(p *Proof, lo, hi int) {
+	switch {
+	case hi <= p.Begin || lo >= p.End:
+		p.Hashes = append(p.Hashes, t.subtree(lo, hi))
+	case lo >= p.Begin && hi <= p.End:
+	default:
+		k := lo + split(hi-lo)
+		t.collect(p, lo, k)
+		t.collect(p, k, hi)
+	}
+}
+
+func checkRange(size, begin, end int) error {
+	if begin < 0 || end > size || begin >= end {
+		return fmt.Errorf("%w: [%d, %d) of %d leaves", ErrBadRange, begin, end, size)
+	}
+
+	return nil
+}
+
+// Verify checks that chunks are leaves [Begin, End) of the tree with the given root.
+func (p *Proof) Verify(root Hash, chunks [][]byte) error {
+	leaves := make([]Hash, len(chunks))
+	for i := range chunks {
+		leaves[i] = LeafHash(chunks[i])
+	}
+
+	return p.VerifyLeaves(root, leaves)
+}
+
+// VerifyLeaves is the same as Verify, but accepts leaf hashes.
+func (p *Proof) VerifyLeaves(root Hash, leaves []Hash) error {
+	if err := checkRange(p.Size, p.Begin, p.End); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadProof, err)
+	} else if uint64(p.Size) > MaxSize {
+		return fmt.Errorf("%w: %d leaves, max %d", ErrBadProof, p.Size, MaxSize)
+	} else if len(leaves) != p.End-p.Begin {
+		return fmt.Errorf("%w: %d leaves for range [%d, %d)", ErrBadProof, len(leaves), p.Begin, p.End)
+	}
+
+	v := verifier{proof: p, leaves: leaves}
+
+	actual, err := v.subtree(0, p.Size)
+	if err != nil {
+		return err
+	} else if len(v.hashes()) != 0 {
+		return fmt.Errorf("%w: %d unused hashes", ErrBadProof, len(v.hashes()))
+	} else if actual != root {
+		return ErrRootMismatch
+	}
+
+	return nil
+}
+
+type verifier struct {
+	proof  *Proof
+	leaves []Hash
+	used   int
+}
+
+func (v *verifier) hashes() []Hash {
+	return v.proof.Hashes[v.used:]
+}
+
+func (v *verifier) subtree(lo, hi int) (Hash, error) {
+	p := v.proof
+
+	switch {
+	case hi <= p.Begin || lo >= p.End:
+		if len(v.hashes()) == 0 {
+			return Hash{}, fmt.Errorf("%w: not enough hashes", ErrBadProof)
+		}
+
+		v.used++
+
+		return p.Hashes[v.used-1], nil
+	case hi-lo == 1:
+		return v.leaves[lo-p.Begin], nil
+	default:
+		k := lo + split(hi-lo)
+
+		left, err := v.subtree(lo, k)
+		if err != nil {
+			return Hash{}, err
+		}
+
+		right, err := v.subtree(k, hi)
+		if err != nil {
+			return Hash{}, err
+		}
+
+		return nodeHash(left, right), nil
+	}
+}
+
+// Bytes encodes proof: size, begin and end as 8-byte big-endian integers
+// followed by hashes.
+func (p *Proof) Bytes() []byte {
+	data := make([]byte, 0, 24+len(p.Hashes)*HashSize)
+	data = binary.BigEndian.AppendUint64(data, uint64(p.Size))
+	data = binary.BigEndian.AppendUint64(data, uint64(p.Begin))
+	data = binary.BigEndian.AppendUint64(data, uint64(p.End))
+
+	for i := range p.Hashes {
+		data = append(data, p.Hashes[i][:]...)
+	}
+
+	return data
+}
+
+// DecodeProof decodes proof produced by Proof.Bytes.
+func DecodeProof(data []byte) (*Proof, error) {
+	if len(data) < 24 || (len(data)-24)%HashSize != 0 {
+		return nil, fmt.Errorf("%w: wrong length %d", ErrBadProof, len(data))
+	}
+
+	var (
+		size  = binary.BigEndian.Uint64(data)
+		begin = binary.BigEndian.Uint64(data[8:])
+		end   = binary.BigEndian.Uint64(data[16:])
+	)
+
+	if size > MaxSize || size > uint64(^uint(0)>>1) {
+		return nil, fmt.Errorf("%w: %d leaves, max %d", ErrBadProof, size, MaxSize)
+	} else if begin >= end || end > size {
+		return nil, fmt.Errorf("%w: bad range [%d, %d) of %d", ErrBadProof, begin, end, size)
+	}
+
+	p := &Proof{
+		Size:  int(size),
+		Begin: int(begin),
+		End:   int(end),
+	}
+
+	if n := (len(data) - 24) / HashSize; n > 0 {
+		p.Hashes = make([]Hash, n)
+	}
+
+	for i := range p.Hashes {
+		copy(p.Hashes[i][:], data[24+i*HashSize:])
+	}
+
+	return p, nil
+}
+
+// Sign signs tree root using crypto.SignRFC6979Hash.
+func (t *Tree) Sign(key *ecdsa.PrivateKey) ([]byte, error) {
+	return crypto.SignRFC6979Hash(key, t.root[:])
+}
+
+// VerifyRoot verifies root signature produced by Tree.Sign.
+func VerifyRoot(pub *ecdsa.PublicKey, root Hash, sig []byte) error {
+	return crypto.VerifyRFC6979Hash(pub, root[:], sig)
+}
+
+// VerifyChunks checks root signature and then that chunks belong to the
+// signed tree according to proof.
+func VerifyChunks(pub *ecdsa.PublicKey, root Hash, sig []byte, proof *Proof, chunks [][]byte) error {
+	if err := VerifyRoot(pub, root, sig); err != nil {
+		return err
+	}
+
+	return proof.Verify(root, chunks)
+}
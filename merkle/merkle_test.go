@@ -0,0 +1,198 @@
+package merkle
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/stretchr/testify/require"
+)
+
+// RFC 6962 test data from certificate-transparency reference implementation.
+var (
+	ctLeaves = []string{
+		"", "00", "10", "2021", "3031", "40414243",
+		"5051525354555657", "606162636465666768696a6b6c6d6e6f",
+	}
+
+	ctRoots = []string{
+		"6e340b9cffb37a989ca544e6bb780a2c78901d3fb33738768511a30617afa01d",
+		"fac54203e7cc696cf0dfcb42c92a1d9dbaf70ad9e621f4bd8d98662f00e3c125",
+		"aeb6bcfe274b70a14fb067a5e5578264db0fa9b51af5e0ba159158f329e06e77",
+		"d37ee418976dd95753c1c73862b9398fa2a2cf9b4ff0fdfe8b30cd95209614b7",
+		"4e3bbb1f7b478dcfe71fb631631519a3bca12c9aefca1612bfce4c13a86264d4",
+		"76e67dadbcdf1e10e1b74ddc608abd2f98dfb16fbce75277b5232a127f2087ef",
+		"ddb89be403809e325750d3d263cd78929c2942b7942a34b77e122c9594a74c8c",
+		"5dc9da79a70659a9ad559cb701ded9a2ab9d823aad2f4960cfe370eff4604328",
+	}
+)
+
+func ctChunks(t *testing.T) [][]byte {
+	chunks := make([][]byte, len(ctLeaves))
+
+	for i := range ctLeaves {
+		var err error
+
+		chunks[i], err = hex.DecodeString(ctLeaves[i])
+		require.NoError(t, err)
+	}
+
+	return chunks
+}
+
+func TestRoot(t *testing.T) {
+	chunks := ctChunks(t)
+
+	for i := range ctRoots {
+		root := New(chunks[:i+1]).Root()
+		require.Equal(t, ctRoots[i], hex.EncodeToString(root[:]), "%d leaves", i+1)
+	}
+
+	empty := New(nil).Root()
+	require.Equal(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", hex.EncodeToString(empty[:]))
+}
+
+func TestProofs(t *testing.T) {
+	chunks := make([][]byte, 13)
+	for i := range chunks {
+		chunks[i] = []byte{byte(i), byte(i * 7)}
+	}
+
+	for size := 1; size <= len(chunks); size++ {
+		tree := New(chunks[:size])
+		root := tree.Root()
+
+		for begin := 0; begin < size; begin++ {
+			for end := begin + 1; end <= size; end++ {
+				p, err := tree.RangeProof(begin, end)
+				require.NoError(t, err)
+				require.NoError(t, p.Verify(root, chunks[begin:end]), "size %d, [%d, %d)", size, begin, end)
+
+				decoded, err := DecodeProof(p.Bytes())
+				require.NoError(t, err)
+				require.Equal(t, p, decoded)
+			}
+		}
+	}
+
+	tree := New(chunks)
+	root := tree.Root()
+
+	t.Run("single leaf", func(t *testing.T) {
+		p, err := tree.Proof(5)
+		require.NoError(t, err)
+		require.NoError(t, p.Verify(root, chunks[5:6]))
+		require.ErrorIs(t, p.Verify(root, chunks[6:7]), ErrRootMismatch)
+	})
+
+	t.Run("wrong leaves", func(t *testing.T) {
+		p, err := tree.RangeProof(2, 6)
+		require.NoError(t, err)
+
+		require.ErrorIs(t, p.Verify(root, chunks[3:7]), ErrRootMismatch)
+		require.ErrorIs(t, p.Verify(root, chunks[2:5]), ErrBadProof)
+
+		bad := *p
+		bad.Hashes = bad.Hashes[1:]
+		require.ErrorIs(t, bad.Verify(root, chunks[2:6]), ErrBadProof)
+
+		bad.Hashes = append(append([]Hash(nil), p.Hashes...), Hash{})
+		require.ErrorIs(t, bad.Verify(root, chunks[2:6]), ErrBadProof)
+	})
+
+	t.Run("bad range", func(t *testing.T) {
+		for _, r := range [][2]int{{-1, 1}, {3, 3}, {4, 2}, {0, 14}} {
+			_, err := tree.RangeProof(r[0], r[1])
+			require.ErrorIs(t, err, ErrBadRange)
+		}
+
+		_, err := DecodeProof([]byte{1, 2, 3})
+		require.ErrorIs(t, err, ErrBadProof)
+	})
+
+	t.Run("huge size", func(t *testing.T) {
+		leaves := []Hash{LeafHash(chunks[0])}
+
+		for _, size := range []uint64{MaxSize + 1, 1<<62 + 5, 1<<63 - 1} {
+			p := &Proof{Size: 0, Begin: 0, End: 1}
+			data := p.Bytes()
+			binary.BigEndian.PutUint64(data, size)
+
+			_, err := DecodeProof(data)
+			require.ErrorIs(t, err, ErrBadProof, size)
+
+			p.Size = int(size)
+			require.ErrorIs(t, p.VerifyLeaves(root, leaves), ErrBadProof, size)
+		}
+
+		p := &Proof{Size: MaxSize, Begin: 0, End: 1}
+		decoded, err := DecodeProof(p.Bytes())
+		require.NoError(t, err)
+		require.ErrorIs(t, decoded.VerifyLeaves(root, leaves), ErrBadProof)
+	})
+}
+
+func TestSplit(t *testing.T) {
+	for n, expected := range map[int]int{
+		2:         1,
+		3:         2,
+		4:         2,
+		5:         4,
+		13:        8,
+		1<<62 + 5: 1 << 62,
+		1<<63 - 1: 1 << 62,
+	} {
+		require.Equal(t, expected, split(n), n)
+	}
+}
+
+func TestNewFromReader(t *testing.T) {
+	payload := make([]byte, 10*1024+5)
+	_, err := rand.Read(payload)
+	require.NoError(t, err)
+
+	var chunks [][]byte
+	for p := payload; len(p) > 0; {
+		n := 1024
+		if n > len(p) {
+			n = len(p)
+		}
+
+		chunks = append(chunks, p[:n])
+		p = p[n:]
+	}
+
+	tree, err := NewFromReader(bytes.NewReader(payload), 1024)
+	require.NoError(t, err)
+	require.Equal(t, 11, tree.Len())
+	require.Equal(t, New(chunks).Root(), tree.Root())
+
+	_, err = NewFromReader(bytes.NewReader(payload), 0)
+	require.ErrorIs(t, err, ErrBadChunkSize)
+}
+
+func TestSignedRoot(t *testing.T) {
+	var (
+		key    = test.DecodeKey(0)
+		chunks = ctChunks(t)
+		tree   = New(chunks)
+	)
+
+	sig, err := tree.Sign(key)
+	require.NoError(t, err)
+	require.Len(t, sig, crypto.RFC6979SignatureSize)
+
+	root := tree.Root()
+	require.NoError(t, VerifyRoot(&key.PublicKey, root, sig))
+
+	p, err := tree.RangeProof(3, 5)
+	require.NoError(t, err)
+	require.NoError(t, VerifyChunks(&key.PublicKey, root, sig, p, chunks[3:5]))
+
+	require.ErrorIs(t, VerifyChunks(&test.DecodeKey(1).PublicKey, root, sig, p, chunks[3:5]), crypto.ErrWrongSignature)
+	require.ErrorIs(t, VerifyChunks(&key.PublicKey, root, sig, p, chunks[4:6]), ErrRootMismatch)
+}
@@ -0,0 +1,43 @@
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-crypto/internal"
+	"github.com/nspcc-dev/neofs-crypto/mnemonic"
+)
+
+// MnemonicEncode encodes the given private key into 24-word BIP39 mnemonic
+// phrase. D-bytes of the key are used as entropy directly, so the phrase
+// can be decoded back by MnemonicDecode.
+func MnemonicEncode(key *ecdsa.PrivateKey) (string, error) {
+	if key == nil || key.D == nil {
+		return "", ErrEmptyPrivateKey
+	} else if err := checkPrivateKeyWidth(key.D); err != nil {
+		return "", err
+	}
+
+	data := MarshalPrivateKey(key)
+	defer internal.WipeBytes(data)
+
+	return mnemonic.FromEntropy(data)
+}
+
+// MnemonicDecode decodes private key from 24-word BIP39 mnemonic phrase
+// produced by MnemonicEncode.
+func MnemonicDecode(phrase string) (*ecdsa.PrivateKey, error) {
+	data, err := mnemonic.ToEntropy(phrase)
+	if err != nil {
+		return nil, err
+	}
+
+	defer internal.WipeBytes(data)
+
+	if ln := len(data); ln != PrivateKeyCompressedSize {
+		return nil, fmt.Errorf("%w: actual=%d, expect=%d",
+			ErrWrongPrivateKeySize, ln, PrivateKeyCompressedSize)
+	}
+
+	return UnmarshalPrivateKey(data)
+}
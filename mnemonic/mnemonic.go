@@ -0,0 +1,214 @@
+/*
+Package mnemonic implements BIP39 mnemonic phrases: generation, checksum
+validation and seed derivation. Only the English word list is supported.
+*/
+package mnemonic
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	_ "embed"
+	"fmt"
+	"strings"
+
+	"github.com/nspcc-dev/neofs-crypto/internal"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// MinEntropySize is the minimal entropy size in bits.
+	MinEntropySize = 128
+
+	// MaxEntropySize is the maximal entropy size in bits.
+	MaxEntropySize = 256
+
+	// SeedSize is the size of seed derived from mnemonic.
+	SeedSize = 64
+
+	// seedIterations is a number of PBKDF2 iterations defined by BIP39.
+	seedIterations = 2048
+
+	// bitsPerWord is a number of bits encoded by one word.
+	bitsPerWord = 11
+
+	// ErrBadEntropy when entropy has wrong length.
+	ErrBadEntropy = internal.Error("bad entropy length")
+
+	// ErrBadMnemonic when phrase has wrong number of words or unknown words.
+	ErrBadMnemonic = internal.Error("bad mnemonic")
+
+	// ErrBadChecksum when phrase checksum doesn't match.
+	ErrBadChecksum = internal.Error("bad mnemonic checksum")
+)
+
+//go:embed english.txt
+var english string
+
+var (
+	wordList  = strings.Split(strings.TrimSpace(english), "\n")
+	wordIndex = make(map[string]int, len(wordList))
+)
+
+func init() {
+	for i, w := range wordList {
+		wordIndex[w] = i
+	}
+}
+
+// NewEntropy returns random entropy of the given size in bits.
+// Size must be a multiple of 32 in [128, 256] range.
+func NewEntropy(bits int) ([]byte, error) {
+	if err := checkEntropySize(bits); err != nil {
+		return nil, err
+	}
+
+	entropy := make([]byte, bits/8)
+	if _, err := rand.Read(entropy); err != nil {
+		return nil, err
+	}
+
+	return entropy, nil
+}
+
+// Generate returns new random mnemonic phrase for the entropy of the given
+// size in bits.
+func Generate(bits int) (string, error) {
+	entropy, err := NewEntropy(bits)
+	if err != nil {
+		return "", err
+	}
+
+	defer internal.WipeBytes(entropy)
+
+	return FromEntropy(entropy)
+}
+
+// FromEntropy encodes entropy into mnemonic phrase.
+func FromEntropy(entropy []byte) (string, error) {
+	bits := len(entropy) * 8
+	if err := checkEntropySize(bits); err != nil {
+		return "", err
+	}
+
+	var (
+		sum      = sha256.Sum256(entropy)
+		csBits   = bits / 32
+		wordsNum = (bits + csBits) / bitsPerWord
+		words    = make([]string, wordsNum)
+	)
+
+	// checksum is at most 8 bits, so it fits into one extra byte
+	data := make([]byte, len(entropy)+1)
+	defer internal.WipeBytes(data)
+
+	copy(data, entropy)
+	data[len(entropy)] = sum[0]
+
+	for i := range words {
+		words[i] = wordList[readBits(data, i*bitsPerWord, bitsPerWord)]
+	}
+
+	return strings.Join(words, " "), nil
+}
+
+// ToEntropy decodes mnemonic phrase into entropy and validates its checksum.
+func ToEntropy(phrase string) ([]byte, error) {
+	words := strings.Fields(phrase)
+
+	wordsNum := len(words)
+	if wordsNum%3 != 0 || wordsNum < 12 || wordsNum > 24 {
+		return nil, fmt.Errorf("%w: wrong number of words %d", ErrBadMnemonic, wordsNum)
+	}
+
+	var (
+		total  = wordsNum * bitsPerWord
+		csBits = total / 33
+		bits   = total - csBits
+		data   = make([]byte, (total+7)/8)
+	)
+
+	defer internal.WipeBytes(data)
+
+	for i, w := range words {
+		index, ok := wordIndex[strings.ToLower(w)]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown word #%d", ErrBadMnemonic, i+1)
+		}
+
+		writeBits(data, i*bitsPerWord, bitsPerWord, index)
+	}
+
+	entropy := make([]byte, bits/8)
+	copy(entropy, data)
+
+	sum := sha256.Sum256(entropy)
+	if readBits(data, bits, csBits) != int(sum[0]>>(8-csBits)) {
+		internal.WipeBytes(entropy)
+		return nil, ErrBadChecksum
+	}
+
+	return entropy, nil
+}
+
+// Validate checks that phrase consists of known words and has valid checksum.
+func Validate(phrase string) error {
+	entropy, err := ToEntropy(phrase)
+	internal.WipeBytes(entropy)
+
+	return err
+}
+
+// Seed derives 64-byte seed from mnemonic phrase and optional passphrase
+// using PBKDF2-HMAC-SHA512 as defined by BIP39. Phrase is validated and
+// normalized like Validate does: words are lowercased and separated by
+// single spaces, so the seed doesn't depend on case and spacing.
+func Seed(phrase, passphrase string) ([]byte, error) {
+	if err := Validate(phrase); err != nil {
+		return nil, err
+	}
+
+	phrase = strings.Join(strings.Fields(strings.ToLower(phrase)), " ")
+	salt := "mnemonic" + norm.NFKD.String(passphrase)
+
+	return pbkdf2.Key([]byte(norm.NFKD.String(phrase)), []byte(salt), seedIterations, SeedSize, sha512.New), nil
+}
+
+// Words returns the list of words used by the package.
+func Words() []string {
+	res := make([]string, len(wordList))
+	copy(res, wordList)
+
+	return res
+}
+
+func checkEntropySize(bits int) error {
+	if bits%32 != 0 || bits < MinEntropySize || bits > MaxEntropySize {
+		return fmt.Errorf("%w: %d bits, expect multiple of 32 in [%d, %d]",
+			ErrBadEntropy, bits, MinEntropySize, MaxEntropySize)
+	}
+
+	return nil
+}
+
+// readBits reads n bits big-endian starting from bit offset.
+func readBits(data []byte, offset, n int) int {
+	var res int
+
+	for i := offset; i < offset+n; i++ {
+		res = res<<1 | int(data[i/8]>>(7-i%8)&1)
+	}
+
+	return res
+}
+
+// writeBits writes n lower bits of v big-endian starting from bit offset.
+func writeBits(data []byte, offset, n, v int) {
+	for i := 0; i < n; i++ {
+		if v>>(n-1-i)&1 == 1 {
+			pos := offset + i
+			data[pos/8] |= 1 << (7 - pos%8)
+		}
+	}
+}
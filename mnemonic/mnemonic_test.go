@@ -0,0 +1,99 @@
+package mnemonic
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Trezor BIP39 test vectors, passphrase "TREZOR".
+var vectors = []struct {
+	entropy string
+	phrase  string
+	seed    string
+}{
+	{
+		entropy: "00000000000000000000000000000000",
+		phrase:  "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		seed:    "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+	},
+	{
+		entropy: "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+		phrase:  "legal winner thank year wave sausage worth useful legal winner thank yellow",
+		seed:    "2e8905819b8723fe2c1d161860e5ee1830318dbf49a83bd451cfb8440c28bd6fa457fe1296106559a3c80937a1c1069be3a3a5bd381ee6260e8d9739fce1f607",
+	},
+	{
+		entropy: "ffffffffffffffffffffffffffffffff",
+		phrase:  "zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo wrong",
+		seed:    "ac27495480225222079d7be181583751e86f571027b0497b5b5d11218e0a8a13332572917f0f8e5a589620c6f15b11c61dee327651a14c34e18231052e48c069",
+	},
+	{
+		entropy: "0000000000000000000000000000000000000000000000000000000000000000",
+		phrase: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon " +
+			"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art",
+		seed: "bda85446c68413707090a52022edd26a1c9462295029f2e60cd7c4f2bbd3097170af7a4d73245cafa9c3cca8d561a7c3de6f5d4a10be8ed2a5e608d68f92fcc8",
+	},
+	{
+		entropy: "9e885d952ad362caeb4efe34a8e91bd2",
+		phrase:  "ozone drill grab fiber curtain grace pudding thank cruise elder eight picnic",
+		seed:    "274ddc525802f7c828d8ef7ddbcdc5304e87ac3535913611fbbfa986d0c9e5476c91689f9c8a54fd55bd38606aa6a8595ad213d4c9c9f9aca3fb217069a41028",
+	},
+}
+
+func TestVectors(t *testing.T) {
+	for _, v := range vectors {
+		entropy, err := hex.DecodeString(v.entropy)
+		require.NoError(t, err)
+
+		phrase, err := FromEntropy(entropy)
+		require.NoError(t, err)
+		require.Equal(t, v.phrase, phrase)
+
+		actual, err := ToEntropy(phrase)
+		require.NoError(t, err)
+		require.Equal(t, entropy, actual)
+
+		seed, err := Seed(phrase, "TREZOR")
+		require.NoError(t, err)
+		require.Equal(t, v.seed, hex.EncodeToString(seed))
+
+		seed, err = Seed("  "+strings.ToUpper(phrase)+"\n", "TREZOR")
+		require.NoError(t, err)
+		require.Equal(t, v.seed, hex.EncodeToString(seed))
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	for bits := MinEntropySize; bits <= MaxEntropySize; bits += 32 {
+		phrase, err := Generate(bits)
+		require.NoError(t, err)
+		require.Len(t, strings.Fields(phrase), (bits+bits/32)/11)
+		require.NoError(t, Validate(phrase))
+	}
+
+	for _, bits := range []int{0, 96, 100, 288} {
+		_, err := Generate(bits)
+		require.ErrorIs(t, err, ErrBadEntropy)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate("  Legal winner thank year wave sausage\nworth useful legal winner thank yellow "))
+
+	require.ErrorIs(t, Validate("legal winner thank year wave sausage worth useful legal winner thank zoo"), ErrBadChecksum)
+	require.ErrorIs(t, Validate("legal winner thank year wave sausage worth useful legal winner thank neofs"), ErrBadMnemonic)
+	require.ErrorIs(t, Validate("legal winner thank"), ErrBadMnemonic)
+	require.ErrorIs(t, Validate(""), ErrBadMnemonic)
+
+	_, err := Seed("zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo", "")
+	require.ErrorIs(t, err, ErrBadChecksum)
+}
+
+func TestWords(t *testing.T) {
+	words := Words()
+	require.Len(t, words, 2048)
+	require.Equal(t, "abandon", words[0])
+	require.Equal(t, "zoo", words[2047])
+}
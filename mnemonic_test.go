@@ -0,0 +1,59 @@
+package crypto
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-crypto/mnemonic"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMnemonic(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		expected := test.DecodeKey(i)
+
+		phrase, err := MnemonicEncode(expected)
+		require.NoError(t, err)
+		require.Len(t, strings.Fields(phrase), 24)
+
+		actual, err := MnemonicDecode(phrase)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+
+		actual, err = LoadPrivateKey(phrase)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+	}
+
+	t.Run("from file", func(t *testing.T) {
+		expected := test.DecodeKey(0)
+
+		phrase, err := MnemonicEncode(expected)
+		require.NoError(t, err)
+
+		path := filepath.Join(t.TempDir(), "mnemonic.txt")
+		require.NoError(t, os.WriteFile(path, []byte(phrase+"\n"), 0o600))
+
+		actual, err := LoadPrivateKey(path)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := MnemonicEncode(nil)
+		require.ErrorIs(t, err, ErrEmptyPrivateKey)
+
+		short, err := mnemonic.Generate(128)
+		require.NoError(t, err)
+
+		_, err = MnemonicDecode(short)
+		require.ErrorIs(t, err, ErrWrongPrivateKeySize)
+
+		zero := strings.Repeat("abandon ", 23) + "art"
+		_, err = MnemonicDecode(zero)
+		require.ErrorIs(t, err, ErrInvalidPrivateKey)
+	})
+}
@@ -0,0 +1,82 @@
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+)
+
+// NetworkSigner signs Neo signable data for the particular network. Network
+// magic is always prefixed to the data hash, so signature made for one
+// network is invalid in any other.
+type NetworkSigner struct {
+	key   *ecdsa.PrivateKey
+	magic uint32
+}
+
+// NetworkVerifier verifies signatures made by NetworkSigner for the same network.
+type NetworkVerifier struct {
+	pub   *ecdsa.PublicKey
+	magic uint32
+}
+
+// NewNetworkSigner returns signer for the network with the given magic.
+func NewNetworkSigner(key *ecdsa.PrivateKey, magic uint32) *NetworkSigner {
+	return &NetworkSigner{key: key, magic: magic}
+}
+
+// Magic returns network magic of the signer.
+func (s *NetworkSigner) Magic() uint32 {
+	return s.magic
+}
+
+// Verifier returns NetworkVerifier for signer public key and network.
+func (s *NetworkSigner) Verifier() *NetworkVerifier {
+	if s.key == nil {
+		return NewNetworkVerifier(nil, s.magic)
+	}
+
+	return NewNetworkVerifier(&s.key.PublicKey, s.magic)
+}
+
+// Sign signs signable data (e.g. unsigned transaction): SignRFC6979 is
+// applied to magic || sha256(data).
+func (s *NetworkSigner) Sign(data []byte) ([]byte, error) {
+	return s.SignHash(sha256.Sum256(data))
+}
+
+// SignHash signs data by its hash (e.g. transaction hash): SignRFC6979 is
+// applied to magic || hash.
+func (s *NetworkSigner) SignHash(hash [sha256.Size]byte) ([]byte, error) {
+	if s.key == nil {
+		return nil, ErrEmptyPrivateKey
+	}
+
+	return SignRFC6979(s.key, networkSignedData(s.magic, hash))
+}
+
+// Witness returns single-signature witness for the transaction hash.
+func (s *NetworkSigner) Witness(txHash [sha256.Size]byte) (*Witness, error) {
+	return NewWitness(s.key, s.magic, txHash)
+}
+
+// NewNetworkVerifier returns verifier for the network with the given magic.
+func NewNetworkVerifier(pub *ecdsa.PublicKey, magic uint32) *NetworkVerifier {
+	return &NetworkVerifier{pub: pub, magic: magic}
+}
+
+// Magic returns network magic of the verifier.
+func (v *NetworkVerifier) Magic() uint32 {
+	return v.magic
+}
+
+// Verify verifies signature of signable data made by NetworkSigner.Sign.
+// It returns nil only if signature is valid.
+func (v *NetworkVerifier) Verify(data, sig []byte) error {
+	return v.VerifyHash(sha256.Sum256(data), sig)
+}
+
+// VerifyHash verifies signature of data hash made by NetworkSigner.SignHash.
+// It returns nil only if signature is valid.
+func (v *NetworkVerifier) VerifyHash(hash [sha256.Size]byte, sig []byte) error {
+	return VerifyRFC6979(v.pub, networkSignedData(v.magic, hash), sig)
+}
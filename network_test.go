@@ -0,0 +1,67 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkSigner(t *testing.T) {
+	const (
+		mainNet = 860833102
+		testNet = 894710606
+	)
+
+	key := test.DecodeKey(1)
+	tx := []byte("unsigned transaction")
+	txHash := sha256.Sum256(tx)
+
+	signer := NewNetworkSigner(key, mainNet)
+	require.EqualValues(t, mainNet, signer.Magic())
+
+	sig, err := signer.Sign(tx)
+	require.NoError(t, err)
+
+	byHash, err := signer.SignHash(txHash)
+	require.NoError(t, err)
+	require.Equal(t, sig, byHash)
+
+	verifier := signer.Verifier()
+	require.EqualValues(t, mainNet, verifier.Magic())
+	require.NoError(t, verifier.Verify(tx, sig))
+	require.NoError(t, verifier.VerifyHash(txHash, sig))
+
+	t.Run("witness compatibility", func(t *testing.T) {
+		w, err := signer.Witness(txHash)
+		require.NoError(t, err)
+		require.Equal(t, InvocationScript(sig), w.InvocationScript)
+		require.NoError(t, w.Verify(mainNet, txHash))
+		require.NoError(t, VerifyRFC6979Hash(&key.PublicKey, SignedTxHash(mainNet, txHash), sig))
+	})
+
+	t.Run("replay", func(t *testing.T) {
+		other := NewNetworkVerifier(&key.PublicKey, testNet)
+		require.ErrorIs(t, other.Verify(tx, sig), ErrWrongSignature)
+
+		testSig, err := NewNetworkSigner(key, testNet).Sign(tx)
+		require.NoError(t, err)
+		require.NotEqual(t, sig, testSig)
+		require.ErrorIs(t, verifier.Verify(tx, testSig), ErrWrongSignature)
+
+		require.ErrorIs(t, VerifyRFC6979(&key.PublicKey, tx, sig), ErrWrongSignature)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		require.ErrorIs(t, verifier.Verify([]byte("other transaction"), sig), ErrWrongSignature)
+		require.ErrorIs(t, NewNetworkVerifier(&test.DecodeKey(2).PublicKey, mainNet).Verify(tx, sig), ErrWrongSignature)
+		require.ErrorIs(t, verifier.Verify(tx, sig[1:]), ErrWrongHashSize)
+
+		empty := NewNetworkSigner(nil, mainNet)
+
+		_, err := empty.Sign(tx)
+		require.ErrorIs(t, err, ErrEmptyPrivateKey)
+		require.ErrorIs(t, empty.Verifier().Verify(tx, sig), ErrEmptyPublicKey)
+	})
+}
@@ -0,0 +1,225 @@
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/nspcc-dev/neofs-crypto/internal"
+)
+
+// KeyFormat describes how private key is stored in a file.
+type KeyFormat int
+
+const (
+	// FormatRaw stores D-bytes of private key as is.
+	FormatRaw KeyFormat = iota
+
+	// FormatHex stores D-bytes of private key as hex string.
+	FormatHex
+
+	// FormatWIF stores private key as WIF string.
+	FormatWIF
+
+	// FormatDER stores private key in SEC 1 / ASN.1 DER form.
+	FormatDER
+
+	// FormatPEM stores private key in SEC 1 / ASN.1 DER form wrapped into PEM block.
+	FormatPEM
+
+	// FormatMnemonic stores private key as 24-word mnemonic phrase.
+	FormatMnemonic
+)
+
+const (
+	// ErrKeyFileExists when SavePrivateKey is asked to write into existing file.
+	ErrKeyFileExists = internal.Error("key file already exists")
+
+	// ErrUnknownKeyFormat when passed KeyFormat is not supported.
+	ErrUnknownKeyFormat = internal.Error("unknown key format")
+
+	// ErrBadKeyFile when key file contents match none of supported formats.
+	ErrBadKeyFile = internal.Error("unknown key file format, expect: raw, hex, wif, mnemonic, DER or PEM")
+
+	// pemPrivateKeyType is a PEM block type of SEC 1 private key.
+	pemPrivateKeyType = "EC PRIVATE KEY"
+
+	// keyFilePerm is a permission of saved key files.
+	keyFilePerm = 0o600
+)
+
+// String returns the name of the key format.
+func (f KeyFormat) String() string {
+	switch f {
+	case FormatRaw:
+		return "raw"
+	case FormatHex:
+		return "hex"
+	case FormatWIF:
+		return "wif"
+	case FormatDER:
+		return "der"
+	case FormatPEM:
+		return "pem"
+	case FormatMnemonic:
+		return "mnemonic"
+	default:
+		return fmt.Sprintf("KeyFormat(%d)", int(f))
+	}
+}
+
+// EncodePrivateKey encodes private key into bytes of the given format.
+func EncodePrivateKey(key *ecdsa.PrivateKey, format KeyFormat) ([]byte, error) {
+	if key == nil || key.D == nil {
+		return nil, ErrEmptyPrivateKey
+	} else if err := checkPrivateKeyWidth(key.D); err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case FormatRaw:
+		return MarshalPrivateKey(key), nil
+	case FormatHex:
+		raw := MarshalPrivateKey(key)
+		defer internal.WipeBytes(raw)
+
+		data := make([]byte, hex.EncodedLen(len(raw)))
+		hex.Encode(data, raw)
+
+		return data, nil
+	case FormatWIF:
+		wif, err := WIFEncode(key)
+		if err != nil {
+			return nil, err
+		}
+
+		return []byte(wif), nil
+	case FormatDER:
+		return x509.MarshalECPrivateKey(key)
+	case FormatPEM:
+		der, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			return nil, err
+		}
+
+		defer internal.WipeBytes(der)
+
+		return pem.EncodeToMemory(&pem.Block{Type: pemPrivateKeyType, Bytes: der}), nil
+	case FormatMnemonic:
+		phrase, err := MnemonicEncode(key)
+		if err != nil {
+			return nil, err
+		}
+
+		return []byte(phrase), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKeyFormat, format)
+	}
+}
+
+// SavePrivateKey writes private key into the file in the given format.
+// File is written atomically (via temporary file, fsync and rename)
+// with 0600 permissions. It returns ErrKeyFileExists if file already exists,
+// use OverwritePrivateKey to replace it.
+func SavePrivateKey(path string, key *ecdsa.PrivateKey, format KeyFormat) error {
+	return savePrivateKey(path, key, format, false)
+}
+
+// OverwritePrivateKey is the same as SavePrivateKey, but atomically replaces
+// the file if it already exists.
+func OverwritePrivateKey(path string, key *ecdsa.PrivateKey, format KeyFormat) error {
+	return savePrivateKey(path, key, format, true)
+}
+
+func savePrivateKey(path string, key *ecdsa.PrivateKey, format KeyFormat, overwrite bool) error {
+	data, err := EncodePrivateKey(key, format)
+	if err != nil {
+		return err
+	}
+
+	defer internal.WipeBytes(data)
+
+	if !overwrite {
+		if _, err := os.Lstat(path); err == nil {
+			return fmt.Errorf("%w: %s", ErrKeyFileExists, path)
+		}
+	}
+
+	dir := filepath.Dir(path)
+
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+
+	tmpName := tmp.Name()
+	defer os.Remove(tmpName) // no-op after successful rename
+
+	if err = writeKeyFile(tmp, data); err != nil {
+		return err
+	}
+
+	if overwrite {
+		err = os.Rename(tmpName, path)
+	} else if err = os.Link(tmpName, path); err != nil && !errors.Is(err, os.ErrExist) {
+		// some filesystems (FAT, some network and FUSE mounts) have no
+		// hard links, create the file exclusively and write it directly
+		err = createKeyFile(path, data)
+	}
+
+	if errors.Is(err, os.ErrExist) {
+		// destination appeared after the check above
+		return fmt.Errorf("%w: %s", ErrKeyFileExists, path)
+	} else if err != nil {
+		return err
+	}
+
+	syncDir(dir)
+
+	return nil
+}
+
+// createKeyFile writes data into the new file, it fails with os.ErrExist
+// if the file already exists. Partially written file is removed.
+func createKeyFile(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, keyFilePerm)
+	if err != nil {
+		return err
+	}
+
+	if err = writeKeyFile(f, data); err != nil {
+		_ = os.Remove(path)
+		return err
+	}
+
+	return nil
+}
+
+func writeKeyFile(f *os.File, data []byte) error {
+	if err := f.Chmod(keyFilePerm); err != nil {
+		_ = f.Close()
+		return err
+	} else if _, err = f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	} else if err = f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// syncDir flushes directory entry of renamed file. Some platforms
+// do not support fsync of directories, such errors are ignored.
+func syncDir(dir string) {
+	if d, err := os.Open(dir); err == nil {
+		_ = d.Sync()
+		_ = d.Close()
+	}
+}
@@ -0,0 +1,91 @@
+package crypto
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSavePrivateKey(t *testing.T) {
+	formats := []KeyFormat{FormatRaw, FormatHex, FormatWIF, FormatDER, FormatPEM, FormatMnemonic}
+
+	for _, format := range formats {
+		format := format
+		t.Run(format.String(), func(t *testing.T) {
+			var (
+				key  = test.DecodeKey(0)
+				path = filepath.Join(t.TempDir(), "node.key")
+			)
+
+			require.NoError(t, SavePrivateKey(path, key, format))
+
+			info, err := os.Stat(path)
+			require.NoError(t, err)
+			require.Equal(t, os.FileMode(keyFilePerm), info.Mode().Perm())
+
+			actual, err := LoadPrivateKey(path)
+			require.NoError(t, err)
+			require.Equal(t, key, actual)
+		})
+	}
+
+	t.Run("refuse to overwrite", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "node.key")
+
+		require.NoError(t, SavePrivateKey(path, test.DecodeKey(0), FormatWIF))
+		require.ErrorIs(t, SavePrivateKey(path, test.DecodeKey(1), FormatWIF), ErrKeyFileExists)
+
+		actual, err := LoadPrivateKey(path)
+		require.NoError(t, err)
+		require.Equal(t, test.DecodeKey(0), actual)
+
+		require.NoError(t, OverwritePrivateKey(path, test.DecodeKey(1), FormatWIF))
+
+		actual, err = LoadPrivateKey(path)
+		require.NoError(t, err)
+		require.Equal(t, test.DecodeKey(1), actual)
+
+		entries, err := os.ReadDir(filepath.Dir(path))
+		require.NoError(t, err)
+		require.Len(t, entries, 1, "temporary files must be removed")
+	})
+
+	t.Run("without hard links", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "node.key")
+
+		require.NoError(t, createKeyFile(path, []byte("secret")))
+		require.ErrorIs(t, createKeyFile(path, []byte("another")), os.ErrExist)
+
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(keyFilePerm), info.Mode().Perm())
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, []byte("secret"), data)
+	})
+
+	t.Run("bad key file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "node.key")
+		require.NoError(t, os.WriteFile(path, []byte("not a key"), keyFilePerm))
+
+		_, err := LoadPrivateKey(path)
+		require.ErrorIs(t, err, ErrBadKeyFile)
+
+		_, err = DecodePrivateKey(make([]byte, 31))
+		require.ErrorIs(t, err, ErrBadKeyFile)
+	})
+
+	t.Run("bad arguments", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "node.key")
+
+		require.ErrorIs(t, SavePrivateKey(path, nil, FormatRaw), ErrEmptyPrivateKey)
+		require.ErrorIs(t, SavePrivateKey(path, test.DecodeKey(0), KeyFormat(42)), ErrUnknownKeyFormat)
+
+		_, err := os.Stat(path)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
@@ -0,0 +1,92 @@
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-crypto/internal"
+)
+
+const (
+	// ErrDestroyedKey when SecretKey is used after Destroy call.
+	ErrDestroyedKey = internal.Error("secret key is destroyed")
+
+	// ErrWrongPrivateKeySize when passed D-bytes have wrong length.
+	ErrWrongPrivateKeySize = internal.Error("wrong private key size")
+)
+
+// SecretKey holds D-bytes of private key in a fixed-size array, so the
+// secret is stored in one place and can be wiped explicitly by Destroy.
+type SecretKey struct {
+	d         [PrivateKeyCompressedSize]byte
+	destroyed bool
+}
+
+// NewSecretKey copies D of the given private key into SecretKey.
+// Passed key stays untouched, use DestroyPrivateKey to wipe it.
+func NewSecretKey(key *ecdsa.PrivateKey) (*SecretKey, error) {
+	if key == nil || key.D == nil {
+		return nil, ErrEmptyPrivateKey
+	} else if err := checkPrivateKeyWidth(key.D); err != nil {
+		return nil, err
+	}
+
+	sk := new(SecretKey)
+	key.D.FillBytes(sk.d[:])
+
+	return sk, nil
+}
+
+// DecodeSecretKey copies 32 D-bytes into SecretKey.
+func DecodeSecretKey(data []byte) (*SecretKey, error) {
+	if ln := len(data); ln != PrivateKeyCompressedSize {
+		return nil, fmt.Errorf("%w: actual=%d, expect=%d",
+			ErrWrongPrivateKeySize, ln, PrivateKeyCompressedSize)
+	}
+
+	sk := new(SecretKey)
+	copy(sk.d[:], data)
+
+	return sk, nil
+}
+
+// PrivateKey returns new *ecdsa.PrivateKey built from the secret.
+// Caller is responsible for wiping it with DestroyPrivateKey.
+func (k *SecretKey) PrivateKey() (*ecdsa.PrivateKey, error) {
+	if k.destroyed {
+		return nil, ErrDestroyedKey
+	}
+
+	return UnmarshalPrivateKey(k.d[:])
+}
+
+// PublicKey returns public key corresponding to the secret.
+func (k *SecretKey) PublicKey() (*ecdsa.PublicKey, error) {
+	if k.destroyed {
+		return nil, ErrDestroyedKey
+	}
+
+	x, y := curve.ScalarBaseMult(k.d[:])
+
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+// Destroy wipes the secret. SecretKey can't be used after this call.
+func (k *SecretKey) Destroy() {
+	internal.WipeBytes(k.d[:])
+	k.destroyed = true
+}
+
+// Destroyed returns true if Destroy was called.
+func (k *SecretKey) Destroyed() bool {
+	return k.destroyed
+}
+
+// DestroyPrivateKey wipes D of the given private key in place.
+func DestroyPrivateKey(key *ecdsa.PrivateKey) {
+	if key == nil || key.D == nil {
+		return
+	}
+
+	internal.WipeBigInt(key.D)
+}
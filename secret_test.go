@@ -0,0 +1,66 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretKey(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		expected := test.DecodeKey(0)
+
+		sk, err := NewSecretKey(expected)
+		require.NoError(t, err)
+
+		actual, err := sk.PrivateKey()
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+
+		pub, err := sk.PublicKey()
+		require.NoError(t, err)
+		require.Equal(t, &expected.PublicKey, pub)
+
+		decoded, err := DecodeSecretKey(MarshalPrivateKey(expected))
+		require.NoError(t, err)
+		require.Equal(t, sk, decoded)
+	})
+
+	t.Run("destroy", func(t *testing.T) {
+		sk, err := NewSecretKey(test.DecodeKey(1))
+		require.NoError(t, err)
+
+		sk.Destroy()
+		require.True(t, sk.Destroyed())
+		require.Equal(t, [PrivateKeyCompressedSize]byte{}, sk.d)
+
+		_, err = sk.PrivateKey()
+		require.ErrorIs(t, err, ErrDestroyedKey)
+
+		_, err = sk.PublicKey()
+		require.ErrorIs(t, err, ErrDestroyedKey)
+	})
+
+	t.Run("bad input", func(t *testing.T) {
+		_, err := NewSecretKey(nil)
+		require.ErrorIs(t, err, ErrEmptyPrivateKey)
+
+		_, err = DecodeSecretKey(make([]byte, PrivateKeyCompressedSize-1))
+		require.ErrorIs(t, err, ErrWrongPrivateKeySize)
+	})
+
+	t.Run("destroy private key", func(t *testing.T) {
+		key := test.DecodeKey(2)
+		words := key.D.Bits()
+
+		DestroyPrivateKey(key)
+		require.Zero(t, key.D.Sign())
+
+		for i := range words {
+			require.Zero(t, words[i])
+		}
+
+		require.NotPanics(t, func() { DestroyPrivateKey(nil) })
+	})
+}
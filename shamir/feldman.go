@@ -0,0 +1,167 @@
+package shamir
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/internal"
+)
+
+const (
+	// ErrInvalidShare when share doesn't match dealer commitments.
+	ErrInvalidShare = internal.Error("share doesn't match commitments")
+
+	// ErrBadCommitments when commitments can't be decoded.
+	ErrBadCommitments = internal.Error("bad commitments")
+)
+
+// Commitments are Feldman VSS commitments to polynomial coefficients:
+// a_j·G points in compressed form. The first commitment is the public key
+// of the shared private key.
+type Commitments [][]byte
+
+// SplitVerifiable splits the private key into n shares so that any k of
+// them recover it, like SplitPrivateKey does. Additionally it returns public
+// commitments to the polynomial, so every holder can check its share with
+// VerifyShare.
+func SplitVerifiable(key *ecdsa.PrivateKey, k, n int) ([]Share, Commitments, error) {
+	shares, coeffs, err := split(rand.Reader, key, k, n)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer wipeScalars(coeffs)
+
+	commitments := make(Commitments, len(coeffs))
+	buf := make([]byte, crypto.PrivateKeyCompressedSize)
+
+	defer internal.WipeBytes(buf)
+
+	for i := range coeffs {
+		x, y := curve.ScalarBaseMult(coeffs[i].FillBytes(buf))
+		commitments[i] = crypto.MarshalPublicKey(&ecdsa.PublicKey{Curve: curve, X: x, Y: y})
+	}
+
+	return shares, commitments, nil
+}
+
+// VerifyShare checks that share value s satisfies s·G = Σ C_j·i^j, where
+// i is share index and C_j are commitments. It also checks that share
+// threshold and public key checksum are consistent with commitments.
+func VerifyShare(share Share, commitments Commitments) error {
+	points, err := commitments.points()
+	if err != nil {
+		return err
+	} else if int(share.Threshold) != len(points) {
+		return fmt.Errorf("%w: share #%d threshold %d, commitments %d",
+			ErrInvalidShare, share.Index, share.Threshold, len(points))
+	} else if share.KeyCheck != KeyChecksum(points[0]) {
+		return fmt.Errorf("%w: share #%d public key checksum", ErrInvalidShare, share.Index)
+	} else if share.Index == 0 {
+		return fmt.Errorf("%w: zero index", ErrBadShare)
+	}
+
+	// Σ C_j·i^j by Horner's method: (((C_{k-1})·i + C_{k-2})·i + ...)·i + C_0
+	var (
+		index = new(big.Int).SetInt64(int64(share.Index)).Bytes()
+		x, y  = points[len(points)-1].X, points[len(points)-1].Y
+	)
+
+	for j := len(points) - 2; j >= 0; j-- {
+		x, y = curve.ScalarMult(x, y, index)
+		x, y = curve.Add(x, y, points[j].X, points[j].Y)
+	}
+
+	ex, ey := curve.ScalarBaseMult(share.Value[:])
+	if ex.Cmp(x) != 0 || ey.Cmp(y) != 0 {
+		return fmt.Errorf("%w: share #%d", ErrInvalidShare, share.Index)
+	}
+
+	return nil
+}
+
+// CombineVerifiedShares verifies every share against commitments and
+// recovers the private key. It fails with ErrInvalidShare on the first
+// share which doesn't match commitments, so cheating dealer or holder is
+// detected before reconstruction.
+func CombineVerifiedShares(shares []Share, commitments Commitments) (*ecdsa.PrivateKey, error) {
+	for i := range shares {
+		if err := VerifyShare(shares[i], commitments); err != nil {
+			return nil, err
+		}
+	}
+
+	key, err := CombineShares(shares)
+	if err != nil {
+		return nil, err
+	}
+
+	if pub, _ := commitments.PublicKey(); !pub.Equal(&key.PublicKey) {
+		crypto.DestroyPrivateKey(key)
+		return nil, ErrBadReconstruction
+	}
+
+	return key, nil
+}
+
+// PublicKey returns the public key of the shared private key.
+func (c Commitments) PublicKey() (*ecdsa.PublicKey, error) {
+	if len(c) == 0 {
+		return nil, fmt.Errorf("%w: empty", ErrBadCommitments)
+	}
+
+	pub := crypto.UnmarshalPublicKey(c[0])
+	if pub == nil {
+		return nil, fmt.Errorf("%w: bad point #0", ErrBadCommitments)
+	}
+
+	return pub, nil
+}
+
+// Bytes encodes commitments as concatenation of compressed points.
+func (c Commitments) Bytes() []byte {
+	data := make([]byte, 0, len(c)*crypto.PublicKeyCompressedSize)
+	for i := range c {
+		data = append(data, c[i]...)
+	}
+
+	return data
+}
+
+// DecodeCommitments decodes commitments produced by Commitments.Bytes.
+func DecodeCommitments(data []byte) (Commitments, error) {
+	if len(data) == 0 || len(data)%crypto.PublicKeyCompressedSize != 0 {
+		return nil, fmt.Errorf("%w: wrong length %d", ErrBadCommitments, len(data))
+	}
+
+	c := make(Commitments, len(data)/crypto.PublicKeyCompressedSize)
+	for i := range c {
+		c[i] = data[i*crypto.PublicKeyCompressedSize : (i+1)*crypto.PublicKeyCompressedSize : (i+1)*crypto.PublicKeyCompressedSize]
+	}
+
+	if _, err := c.points(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c Commitments) points() ([]*ecdsa.PublicKey, error) {
+	if len(c) == 0 || len(c) > MaxShares {
+		return nil, fmt.Errorf("%w: wrong number of commitments %d", ErrBadCommitments, len(c))
+	}
+
+	res := make([]*ecdsa.PublicKey, len(c))
+	for i := range c {
+		if len(c[i]) != crypto.PublicKeyCompressedSize {
+			return nil, fmt.Errorf("%w: point #%d is not compressed", ErrBadCommitments, i)
+		} else if res[i] = crypto.UnmarshalPublicKey(c[i]); res[i] == nil {
+			return nil, fmt.Errorf("%w: bad point #%d", ErrBadCommitments, i)
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,67 @@
+package shamir
+
+import (
+	"testing"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeldman(t *testing.T) {
+	key := test.DecodeKey(3)
+
+	shares, commitments, err := SplitVerifiable(key, 3, 5)
+	require.NoError(t, err)
+	require.Len(t, commitments, 3)
+
+	pub, err := commitments.PublicKey()
+	require.NoError(t, err)
+	require.Equal(t, crypto.MarshalPublicKey(&key.PublicKey), crypto.MarshalPublicKey(pub))
+
+	for i := range shares {
+		require.NoError(t, VerifyShare(shares[i], commitments))
+	}
+
+	actual, err := CombineVerifiedShares(shares[2:], commitments)
+	require.NoError(t, err)
+	require.Equal(t, key, actual)
+
+	t.Run("cheating dealer", func(t *testing.T) {
+		bad := shares[1]
+		bad.Value[31] ^= 1
+
+		require.ErrorIs(t, VerifyShare(bad, commitments), ErrInvalidShare)
+
+		_, err := CombineVerifiedShares([]Share{shares[0], bad, shares[2]}, commitments)
+		require.ErrorIs(t, err, ErrInvalidShare)
+	})
+
+	t.Run("foreign commitments", func(t *testing.T) {
+		_, other, err := SplitVerifiable(test.DecodeKey(4), 3, 5)
+		require.NoError(t, err)
+
+		require.ErrorIs(t, VerifyShare(shares[0], other), ErrInvalidShare)
+
+		// same key, different polynomial
+		_, other, err = SplitVerifiable(key, 3, 5)
+		require.NoError(t, err)
+
+		require.ErrorIs(t, VerifyShare(shares[0], other), ErrInvalidShare)
+		require.ErrorIs(t, VerifyShare(shares[0], other[:2]), ErrInvalidShare)
+	})
+
+	t.Run("encoding", func(t *testing.T) {
+		decoded, err := DecodeCommitments(commitments.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, commitments, decoded)
+
+		_, err = DecodeCommitments(commitments.Bytes()[1:])
+		require.ErrorIs(t, err, ErrBadCommitments)
+
+		_, err = DecodeCommitments(make([]byte, crypto.PublicKeyCompressedSize))
+		require.ErrorIs(t, err, ErrBadCommitments)
+
+		require.ErrorIs(t, VerifyShare(shares[0], nil), ErrBadCommitments)
+	})
+}
@@ -0,0 +1,291 @@
+/*
+Package shamir implements Shamir secret sharing of P-256 private keys over
+the curve scalar field. Shares carry their index, threshold and a checksum
+of the compressed public key, so wrong reconstruction is detected.
+*/
+package shamir
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math/big"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/internal"
+)
+
+const (
+	// ShareVersion is the current version of encoded share format.
+	ShareVersion = 1
+
+	// ShareSize is the size of encoded share: version, index, threshold,
+	// public key checksum, value and share checksum.
+	ShareSize = 1 + 1 + 1 + KeyCheckSize + crypto.PrivateKeyCompressedSize + 4
+
+	// KeyCheckSize is the size of public key checksum stored in shares.
+	KeyCheckSize = 4
+
+	// MaxShares is the maximal number of shares.
+	MaxShares = 255
+
+	// ErrBadThreshold when threshold or number of shares is out of range.
+	ErrBadThreshold = internal.Error("bad threshold")
+
+	// ErrNotEnoughShares when number of shares is less than threshold.
+	ErrNotEnoughShares = internal.Error("not enough shares")
+
+	// ErrInconsistentShares when shares belong to different keys or splits.
+	ErrInconsistentShares = internal.Error("inconsistent shares")
+
+	// ErrBadShare when share can't be decoded.
+	ErrBadShare = internal.Error("bad share")
+
+	// ErrBadReconstruction when recovered key doesn't match public key checksum.
+	ErrBadReconstruction = internal.Error("bad reconstruction")
+)
+
+var curve = elliptic.P256()
+
+// Share is a single share of the private key.
+type Share struct {
+	// Index is x coordinate of the share, starts from 1.
+	Index uint8
+	// Threshold is the number of shares required to recover the key.
+	Threshold uint8
+	// KeyCheck is a checksum of compressed public key of the shared key.
+	KeyCheck [KeyCheckSize]byte
+	// Value is y coordinate of the share (big-endian scalar).
+	Value [crypto.PrivateKeyCompressedSize]byte
+}
+
+// KeyChecksum returns the checksum of compressed public key stored in shares.
+func KeyChecksum(pub *ecdsa.PublicKey) [KeyCheckSize]byte {
+	var res [KeyCheckSize]byte
+
+	sum := sha256.Sum256(crypto.MarshalPublicKey(pub))
+	copy(res[:], sum[:])
+
+	return res
+}
+
+// SplitPrivateKey splits the private key into n shares so that any k of
+// them recover it.
+func SplitPrivateKey(key *ecdsa.PrivateKey, k, n int) ([]Share, error) {
+	shares, coeffs, err := split(rand.Reader, key, k, n)
+	wipeScalars(coeffs)
+
+	return shares, err
+}
+
+// split evaluates random polynomial of degree k-1 with free coefficient D
+// at points 1..n. It returns shares and polynomial coefficients.
+func split(r io.Reader, key *ecdsa.PrivateKey, k, n int) ([]Share, []*big.Int, error) {
+	if err := crypto.ValidatePrivateKey(key); err != nil {
+		return nil, nil, err
+	} else if k < 1 || n < k || n > MaxShares {
+		return nil, nil, fmt.Errorf("%w: k=%d, n=%d, expect 1 <= k <= n <= %d",
+			ErrBadThreshold, k, n, MaxShares)
+	}
+
+	order := curve.Params().N
+	coeffs := make([]*big.Int, k)
+	coeffs[0] = new(big.Int).Set(key.D)
+
+	for i := 1; i < k; i++ {
+		c, err := rand.Int(r, order)
+		if err != nil {
+			wipeScalars(coeffs)
+			return nil, nil, err
+		}
+
+		coeffs[i] = c
+	}
+
+	check := KeyChecksum(&key.PublicKey)
+	shares := make([]Share, n)
+
+	for i := range shares {
+		x := big.NewInt(int64(i + 1))
+		y := evaluate(coeffs, x)
+
+		shares[i] = Share{
+			Index:     uint8(i + 1),
+			Threshold: uint8(k),
+			KeyCheck:  check,
+		}
+
+		y.FillBytes(shares[i].Value[:])
+		internal.WipeBigInt(y)
+	}
+
+	return shares, coeffs, nil
+}
+
+// evaluate computes polynomial value at x by Horner's method modulo N.
+func evaluate(coeffs []*big.Int, x *big.Int) *big.Int {
+	order := curve.Params().N
+	y := new(big.Int)
+
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		y.Mul(y, x)
+		y.Add(y, coeffs[i])
+		y.Mod(y, order)
+	}
+
+	return y
+}
+
+// CombineShares recovers the private key from at least threshold shares.
+// It returns ErrBadReconstruction if recovered key doesn't match public key
+// checksum stored in shares.
+func CombineShares(shares []Share) (*ecdsa.PrivateKey, error) {
+	if err := checkShares(shares); err != nil {
+		return nil, err
+	}
+
+	shares = shares[:shares[0].Threshold]
+	order := curve.Params().N
+	secret := new(big.Int)
+
+	// Lagrange interpolation at x = 0
+	for i := range shares {
+		num, den := big.NewInt(1), big.NewInt(1)
+		xi := big.NewInt(int64(shares[i].Index))
+
+		for j := range shares {
+			if i == j {
+				continue
+			}
+
+			xj := big.NewInt(int64(shares[j].Index))
+			num.Mul(num, xj)
+			num.Mod(num, order)
+			den.Mul(den, new(big.Int).Sub(xj, xi))
+			den.Mod(den, order)
+		}
+
+		term := new(big.Int).SetBytes(shares[i].Value[:])
+		term.Mul(term, num)
+		term.Mul(term, den.ModInverse(den, order))
+		secret.Add(secret, term)
+		secret.Mod(secret, order)
+		internal.WipeBigInt(term)
+	}
+
+	d := secret.FillBytes(make([]byte, crypto.PrivateKeyCompressedSize))
+	defer internal.WipeBytes(d)
+	internal.WipeBigInt(secret)
+
+	key, err := crypto.UnmarshalPrivateKey(d)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBadReconstruction, err)
+	} else if KeyChecksum(&key.PublicKey) != shares[0].KeyCheck {
+		crypto.DestroyPrivateKey(key)
+		return nil, ErrBadReconstruction
+	}
+
+	return key, nil
+}
+
+func checkShares(shares []Share) error {
+	if len(shares) == 0 {
+		return ErrNotEnoughShares
+	}
+
+	var (
+		first = shares[0]
+		seen  = make(map[uint8]struct{}, len(shares))
+	)
+
+	if first.Threshold == 0 {
+		return fmt.Errorf("%w: zero threshold", ErrBadShare)
+	} else if len(shares) < int(first.Threshold) {
+		return fmt.Errorf("%w: have %d, need %d", ErrNotEnoughShares, len(shares), first.Threshold)
+	}
+
+	for i := range shares {
+		if shares[i].Threshold != first.Threshold || shares[i].KeyCheck != first.KeyCheck {
+			return fmt.Errorf("%w: share #%d", ErrInconsistentShares, shares[i].Index)
+		} else if shares[i].Index == 0 {
+			return fmt.Errorf("%w: zero index", ErrBadShare)
+		} else if _, ok := seen[shares[i].Index]; ok {
+			return fmt.Errorf("%w: duplicate index %d", ErrInconsistentShares, shares[i].Index)
+		}
+
+		seen[shares[i].Index] = struct{}{}
+	}
+
+	return nil
+}
+
+// Bytes encodes share into ShareSize bytes.
+func (s Share) Bytes() []byte {
+	data := make([]byte, 0, ShareSize)
+	data = append(data, ShareVersion, s.Index, s.Threshold)
+	data = append(data, s.KeyCheck[:]...)
+	data = append(data, s.Value[:]...)
+
+	return append(data, internal.Checksum(data)...)
+}
+
+// String returns hex-encoded share.
+func (s Share) String() string {
+	data := s.Bytes()
+	defer internal.WipeBytes(data)
+
+	return hex.EncodeToString(data)
+}
+
+// DecodeShare decodes share produced by Share.Bytes.
+func DecodeShare(data []byte) (Share, error) {
+	var s Share
+
+	if ln := len(data); ln != ShareSize {
+		return s, fmt.Errorf("%w: actual=%d, expect=%d", ErrBadShare, ln, ShareSize)
+	} else if data[0] != ShareVersion {
+		return s, fmt.Errorf("%w: unsupported version %d", ErrBadShare, data[0])
+	} else if !bytes.Equal(internal.Checksum(data[:ShareSize-4]), data[ShareSize-4:]) {
+		return s, fmt.Errorf("%w: bad checksum", ErrBadShare)
+	}
+
+	s.Index, s.Threshold = data[1], data[2]
+	copy(s.KeyCheck[:], data[3:])
+	copy(s.Value[:], data[3+KeyCheckSize:])
+
+	if s.Index == 0 || s.Threshold == 0 {
+		return Share{}, fmt.Errorf("%w: zero index or threshold", ErrBadShare)
+	}
+
+	return s, nil
+}
+
+// ParseShare decodes hex-encoded share produced by Share.String.
+func ParseShare(s string) (Share, error) {
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		return Share{}, fmt.Errorf("%w: %w", ErrBadShare, err)
+	}
+
+	defer internal.WipeBytes(data)
+
+	return DecodeShare(data)
+}
+
+// Destroy wipes share value.
+func (s *Share) Destroy() {
+	internal.WipeBytes(s.Value[:])
+}
+
+func wipeScalars(list []*big.Int) {
+	for i := range list {
+		if list[i] != nil {
+			internal.WipeBigInt(list[i])
+		}
+	}
+}
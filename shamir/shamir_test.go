@@ -0,0 +1,112 @@
+package shamir
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitCombine(t *testing.T) {
+	key := test.DecodeKey(0)
+
+	shares, err := SplitPrivateKey(key, 3, 5)
+	require.NoError(t, err)
+	require.Len(t, shares, 5)
+
+	// every subset of 3 shares recovers the key
+	for i := 0; i < 5; i++ {
+		for j := i + 1; j < 5; j++ {
+			for l := j + 1; l < 5; l++ {
+				actual, err := CombineShares([]Share{shares[l], shares[i], shares[j]})
+				require.NoError(t, err)
+				require.Equal(t, key, actual)
+			}
+		}
+	}
+
+	t.Run("more than threshold", func(t *testing.T) {
+		actual, err := CombineShares(shares)
+		require.NoError(t, err)
+		require.Equal(t, key, actual)
+	})
+
+	t.Run("not enough shares", func(t *testing.T) {
+		_, err := CombineShares(shares[:2])
+		require.ErrorIs(t, err, ErrNotEnoughShares)
+
+		_, err = CombineShares(nil)
+		require.ErrorIs(t, err, ErrNotEnoughShares)
+	})
+
+	t.Run("corrupted share", func(t *testing.T) {
+		bad := append([]Share(nil), shares[:3]...)
+		bad[1].Value[5] ^= 0xff
+
+		_, err := CombineShares(bad)
+		require.ErrorIs(t, err, ErrBadReconstruction)
+	})
+
+	t.Run("mixed splits", func(t *testing.T) {
+		other, err := SplitPrivateKey(test.DecodeKey(1), 3, 5)
+		require.NoError(t, err)
+
+		_, err = CombineShares([]Share{shares[0], shares[1], other[2]})
+		require.ErrorIs(t, err, ErrInconsistentShares)
+
+		_, err = CombineShares([]Share{shares[0], shares[1], shares[1]})
+		require.ErrorIs(t, err, ErrInconsistentShares)
+	})
+
+	t.Run("trivial threshold", func(t *testing.T) {
+		shares, err := SplitPrivateKey(key, 1, 1)
+		require.NoError(t, err)
+
+		actual, err := CombineShares(shares)
+		require.NoError(t, err)
+		require.Equal(t, key, actual)
+	})
+
+	t.Run("bad arguments", func(t *testing.T) {
+		for _, c := range [][2]int{{0, 1}, {3, 2}, {2, 256}} {
+			_, err := SplitPrivateKey(key, c[0], c[1])
+			require.ErrorIs(t, err, ErrBadThreshold)
+		}
+
+		_, err := SplitPrivateKey(nil, 2, 3)
+		require.Error(t, err)
+	})
+}
+
+func TestShareEncoding(t *testing.T) {
+	shares, err := SplitPrivateKey(test.DecodeKey(2), 2, 3)
+	require.NoError(t, err)
+
+	for _, s := range shares {
+		data := s.Bytes()
+		require.Len(t, data, ShareSize)
+
+		decoded, err := DecodeShare(data)
+		require.NoError(t, err)
+		require.Equal(t, s, decoded)
+
+		parsed, err := ParseShare(s.String())
+		require.NoError(t, err)
+		require.Equal(t, s, parsed)
+	}
+
+	data := shares[0].Bytes()
+	data[10] ^= 1
+
+	_, err = DecodeShare(data)
+	require.ErrorIs(t, err, ErrBadShare)
+
+	_, err = DecodeShare(data[:ShareSize-1])
+	require.ErrorIs(t, err, ErrBadShare)
+
+	_, err = ParseShare("not a share")
+	require.ErrorIs(t, err, ErrBadShare)
+
+	shares[0].Destroy()
+	require.Equal(t, [32]byte{}, shares[0].Value)
+}
@@ -0,0 +1,261 @@
+/*
+Package sigfile implements detached signature files. Signature file keeps
+signer public key, signature scheme, optional signing time and comment next
+to the signature itself, so it can be verified without out-of-band metadata.
+
+Signed data is streamed through SHA-256, then the digest together with all
+metadata fields is signed by crypto.SignRFC6979WithDomain, so neither
+timestamp nor comment can be changed without invalidating the signature.
+
+Signature file format (version 1):
+
+	version (1 byte)
+	scheme (1 byte)
+	public key (33 bytes, compressed)
+	timestamp (8 bytes, big-endian Unix seconds, 0 if absent)
+	comment length (2 bytes, big-endian)
+	comment (UTF-8)
+	signature (64 bytes)
+
+Armored form is a PEM block of type "NEOFS SIGNATURE".
+*/
+package sigfile
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"time"
+	"unicode/utf8"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/internal"
+)
+
+// Scheme identifies signature algorithm.
+type Scheme byte
+
+const (
+	// SchemeRFC6979 is deterministic ECDSA over SHA-256 (crypto.SignRFC6979).
+	SchemeRFC6979 Scheme = 1
+)
+
+const (
+	// Version is the current version of signature file format.
+	Version = 1
+
+	// MaxCommentSize is the maximal size of comment in bytes.
+	MaxCommentSize = 0xffff
+
+	// ArmorType is the PEM block type of armored signature.
+	ArmorType = "NEOFS SIGNATURE"
+
+	headerSize = 1 + 1 + crypto.PublicKeyCompressedSize + internal.UnixSize + 2
+
+	// ErrUnsupportedVersion when signature file has unknown version.
+	ErrUnsupportedVersion = internal.Error("unsupported signature file version")
+
+	// ErrUnsupportedScheme when signature file has unknown scheme.
+	ErrUnsupportedScheme = internal.Error("unsupported signature scheme")
+
+	// ErrBadComment when comment is too long or isn't valid UTF-8.
+	ErrBadComment = internal.Error("bad comment")
+
+	// ErrBadSignatureFile when signature file can't be decoded.
+	ErrBadSignatureFile = internal.Error("bad signature file")
+
+	// ErrKeyMismatch when signature is made by another key.
+	ErrKeyMismatch = internal.Error("signature key mismatch")
+)
+
+var domain = crypto.MustRegisterDomain("neofs-crypto/sigfile/v1")
+
+// Signature is a detached signature with metadata.
+type Signature struct {
+	Scheme    Scheme
+	PublicKey *ecdsa.PublicKey
+
+	// Timestamp is the signing time with second precision, zero if absent.
+	Timestamp time.Time
+
+	// Comment is a free-form signed comment.
+	Comment string
+
+	Value []byte
+}
+
+// String returns scheme name.
+func (s Scheme) String() string {
+	switch s {
+	case SchemeRFC6979:
+		return "RFC6979"
+	default:
+		return fmt.Sprintf("Scheme(%d)", byte(s))
+	}
+}
+
+// Sign reads data from r and signs it with the key. Zero timestamp and empty
+// comment are omitted.
+func Sign(key *ecdsa.PrivateKey, r io.Reader, timestamp time.Time, comment string) (*Signature, error) {
+	if key == nil {
+		return nil, crypto.ErrEmptyPrivateKey
+	} else if err := checkComment(comment); err != nil {
+		return nil, err
+	}
+
+	sig := &Signature{
+		Scheme:    SchemeRFC6979,
+		PublicKey: &ecdsa.PublicKey{Curve: key.Curve, X: key.X, Y: key.Y},
+		Comment:   comment,
+	}
+
+	if !timestamp.IsZero() {
+		sig.Timestamp = internal.TruncateTime(timestamp)
+	}
+
+	msg, err := sig.signedData(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if sig.Value, err = crypto.SignRFC6979WithDomain(key, domain, msg); err != nil {
+		return nil, err
+	}
+
+	return sig, nil
+}
+
+// Verify checks that signature is made by pub over data read from r.
+func (s *Signature) Verify(pub *ecdsa.PublicKey, r io.Reader) error {
+	if pub == nil {
+		return crypto.ErrEmptyPublicKey
+	} else if s.PublicKey == nil || !bytes.Equal(crypto.MarshalPublicKey(pub), crypto.MarshalPublicKey(s.PublicKey)) {
+		return ErrKeyMismatch
+	} else if s.Scheme != SchemeRFC6979 {
+		return fmt.Errorf("%w: %s", ErrUnsupportedScheme, s.Scheme)
+	} else if err := checkComment(s.Comment); err != nil {
+		return err
+	}
+
+	msg, err := s.signedData(r)
+	if err != nil {
+		return err
+	}
+
+	return crypto.VerifyRFC6979WithDomain(pub, domain, msg, s.Value)
+}
+
+// signedData returns metadata header followed by SHA-256 of data from r.
+func (s *Signature) signedData(r io.Reader) ([]byte, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return nil, fmt.Errorf("could not read data: %w", err)
+	}
+
+	msg := make([]byte, 0, headerSize+len(s.Comment)+sha256.Size)
+	msg = s.appendHeader(msg)
+
+	return h.Sum(msg), nil
+}
+
+func (s *Signature) appendHeader(buf []byte) []byte {
+	ts := time.Unix(0, 0)
+	if !s.Timestamp.IsZero() {
+		ts = s.Timestamp
+	}
+
+	buf = append(buf, Version, byte(s.Scheme))
+	buf = append(buf, crypto.MarshalPublicKey(s.PublicKey)...)
+	buf = internal.AppendUnix(buf, ts)
+	buf = binary.BigEndian.AppendUint16(buf, uint16(len(s.Comment)))
+
+	return append(buf, s.Comment...)
+}
+
+// Bytes returns binary form of the signature.
+func (s *Signature) Bytes() []byte {
+	buf := make([]byte, 0, headerSize+len(s.Comment)+len(s.Value))
+	buf = s.appendHeader(buf)
+
+	return append(buf, s.Value...)
+}
+
+// Decode parses binary form of the signature.
+func Decode(data []byte) (*Signature, error) {
+	if len(data) < headerSize+crypto.RFC6979SignatureSize {
+		return nil, fmt.Errorf("%w: too short", ErrBadSignatureFile)
+	} else if data[0] != Version {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, data[0])
+	}
+
+	sig := &Signature{Scheme: Scheme(data[1])}
+	if sig.Scheme != SchemeRFC6979 {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedScheme, sig.Scheme)
+	}
+
+	off := 2
+	if sig.PublicKey = crypto.UnmarshalPublicKey(data[off : off+crypto.PublicKeyCompressedSize]); sig.PublicKey == nil {
+		return nil, fmt.Errorf("%w: bad public key", ErrBadSignatureFile)
+	}
+
+	off += crypto.PublicKeyCompressedSize
+
+	if ts := internal.DecodeUnix(data[off:]); ts.Unix() != 0 {
+		sig.Timestamp = ts
+	}
+
+	off += internal.UnixSize
+	n := int(binary.BigEndian.Uint16(data[off:]))
+	off += 2
+
+	if ln := len(data) - off; ln != n+crypto.RFC6979SignatureSize {
+		return nil, fmt.Errorf("%w: actual=%d, expect=%d", ErrBadSignatureFile, ln, n+crypto.RFC6979SignatureSize)
+	}
+
+	sig.Comment = string(data[off : off+n])
+	if err := checkComment(sig.Comment); err != nil {
+		return nil, err
+	}
+
+	sig.Value = append([]byte(nil), data[off+n:]...)
+
+	return sig, nil
+}
+
+// Armor returns armored text form of the signature.
+func (s *Signature) Armor() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: ArmorType, Bytes: s.Bytes()})
+}
+
+// Unarmor parses armored text form of the signature.
+func Unarmor(data []byte) (*Signature, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != ArmorType {
+		return nil, fmt.Errorf("%w: no %s block", ErrBadSignatureFile, ArmorType)
+	}
+
+	return Decode(block.Bytes)
+}
+
+// Parse parses signature in either armored or binary form.
+func Parse(data []byte) (*Signature, error) {
+	if bytes.Contains(data, []byte("-----BEGIN "+ArmorType+"-----")) {
+		return Unarmor(data)
+	}
+
+	return Decode(data)
+}
+
+func checkComment(comment string) error {
+	if ln := len(comment); ln > MaxCommentSize {
+		return fmt.Errorf("%w: actual=%d, max=%d", ErrBadComment, ln, MaxCommentSize)
+	} else if !utf8.ValidString(comment) {
+		return fmt.Errorf("%w: invalid UTF-8", ErrBadComment)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package sigfile
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignature(t *testing.T) {
+	key := test.DecodeKey(1)
+	data := []byte("neofs-node release archive")
+	ts := time.Date(2026, 10, 18, 12, 30, 15, 999, time.Local)
+
+	sig, err := Sign(key, bytes.NewReader(data), ts, "neofs-node v0.42.0")
+	require.NoError(t, err)
+	require.Equal(t, SchemeRFC6979, sig.Scheme)
+	require.Equal(t, ts.Unix(), sig.Timestamp.Unix())
+	require.Zero(t, sig.Timestamp.Nanosecond())
+	require.NoError(t, sig.Verify(&key.PublicKey, bytes.NewReader(data)))
+
+	t.Run("encoding", func(t *testing.T) {
+		decoded, err := Decode(sig.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, sig, decoded)
+		require.NoError(t, decoded.Verify(&key.PublicKey, bytes.NewReader(data)))
+
+		armored := sig.Armor()
+		require.True(t, strings.HasPrefix(string(armored), "-----BEGIN NEOFS SIGNATURE-----\n"))
+
+		decoded, err = Unarmor(armored)
+		require.NoError(t, err)
+		require.Equal(t, sig, decoded)
+
+		for _, form := range [][]byte{sig.Bytes(), armored} {
+			decoded, err = Parse(form)
+			require.NoError(t, err)
+			require.Equal(t, sig, decoded)
+		}
+	})
+
+	t.Run("without metadata", func(t *testing.T) {
+		sig, err := Sign(key, bytes.NewReader(data), time.Time{}, "")
+		require.NoError(t, err)
+		require.Len(t, sig.Bytes(), headerSize+crypto.RFC6979SignatureSize)
+
+		decoded, err := Decode(sig.Bytes())
+		require.NoError(t, err)
+		require.True(t, decoded.Timestamp.IsZero())
+		require.Empty(t, decoded.Comment)
+		require.NoError(t, decoded.Verify(&key.PublicKey, bytes.NewReader(data)))
+	})
+
+	t.Run("tampering", func(t *testing.T) {
+		err := sig.Verify(&key.PublicKey, bytes.NewReader(append(data, '!')))
+		require.ErrorIs(t, err, crypto.ErrWrongSignature)
+
+		err = sig.Verify(&test.DecodeKey(2).PublicKey, bytes.NewReader(data))
+		require.ErrorIs(t, err, ErrKeyMismatch)
+
+		changed := *sig
+		changed.Comment = "neofs-node v0.43.0"
+		require.ErrorIs(t, changed.Verify(&key.PublicKey, bytes.NewReader(data)), crypto.ErrWrongSignature)
+
+		changed = *sig
+		changed.Timestamp = sig.Timestamp.Add(time.Second)
+		require.ErrorIs(t, changed.Verify(&key.PublicKey, bytes.NewReader(data)), crypto.ErrWrongSignature)
+
+		msg, err := sig.signedData(bytes.NewReader(data))
+		require.NoError(t, err)
+
+		changed = *sig
+		changed.Value, err = crypto.SignRFC6979(key, msg)
+		require.NoError(t, err)
+		require.ErrorIs(t, changed.Verify(&key.PublicKey, bytes.NewReader(data)), crypto.ErrWrongSignature)
+	})
+
+	t.Run("bad input", func(t *testing.T) {
+		_, err := Sign(nil, bytes.NewReader(data), ts, "")
+		require.ErrorIs(t, err, crypto.ErrEmptyPrivateKey)
+
+		_, err = Sign(key, bytes.NewReader(data), ts, strings.Repeat("x", MaxCommentSize+1))
+		require.ErrorIs(t, err, ErrBadComment)
+
+		_, err = Sign(key, bytes.NewReader(data), ts, "\xff")
+		require.ErrorIs(t, err, ErrBadComment)
+
+		raw := sig.Bytes()
+
+		_, err = Decode(raw[:len(raw)-1])
+		require.ErrorIs(t, err, ErrBadSignatureFile)
+
+		_, err = Decode(raw[:10])
+		require.ErrorIs(t, err, ErrBadSignatureFile)
+
+		bad := bytes.Clone(raw)
+		bad[0] = Version + 1
+		_, err = Decode(bad)
+		require.ErrorIs(t, err, ErrUnsupportedVersion)
+
+		bad = bytes.Clone(raw)
+		bad[1] = 0
+		_, err = Decode(bad)
+		require.ErrorIs(t, err, ErrUnsupportedScheme)
+
+		_, err = Unarmor(raw)
+		require.ErrorIs(t, err, ErrBadSignatureFile)
+	})
+}
@@ -0,0 +1,429 @@
+/*
+Package stream implements chunked authenticated encryption of large payloads
+to one or more P-256 public keys.
+
+Random file key is wrapped for every recipient with a key derived by ECDH
+between sender private key and recipient public key (see
+crypto.SharedSecret). Payload is split into chunks of ChunkSize bytes and
+every chunk is sealed by AES-256-GCM following STREAM construction: nonce is
+a chunk counter with a flag marking the last chunk, so reordered, dropped
+or truncated chunks are detected.
+
+Stream format (version 1):
+
+	version (1 byte)
+	sender public key (33 bytes, compressed)
+	salt (16 bytes)
+	number of recipients (2 bytes, big-endian)
+	recipients: public key (33 bytes, compressed) || wrapped file key (48 bytes)
+	chunks: AES-GCM output of ChunkSize plaintext bytes, last chunk may be shorter
+
+Payload key is derived from the file key and the hash of the whole header,
+so any header modification makes the payload undecryptable.
+
+Stream doesn't authenticate the sender to multiple recipients: every
+recipient learns the file key, so any of them can keep the header and
+encrypt another payload, which other recipients accept. Sign the payload
+(e.g. with sigfile package) if its origin matters.
+*/
+package stream
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/internal"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// Version is the current version of stream format.
+	Version = 1
+
+	// ChunkSize is the size of plaintext chunk.
+	ChunkSize = 64 * 1024
+
+	// MaxRecipients is the maximal number of stream recipients.
+	MaxRecipients = 0xffff
+
+	fileKeySize    = 32
+	saltSize       = 16
+	tagSize        = 16
+	wrappedKeySize = fileKeySize + tagSize
+	stanzaSize     = crypto.PublicKeyCompressedSize + wrappedKeySize
+	fixedHeader    = 1 + crypto.PublicKeyCompressedSize + saltSize + 2
+	encChunkSize   = ChunkSize + tagSize
+	nonceSize      = 12
+	lastChunkFlag  = 1
+
+	wrapInfo    = "neofs-crypto stream v1 wrap"
+	payloadInfo = "neofs-crypto stream v1 payload"
+
+	// ErrNoRecipients when stream is created without recipients.
+	ErrNoRecipients = internal.Error("no recipients")
+
+	// ErrNotRecipient when private key can't unwrap stream file key.
+	ErrNotRecipient = internal.Error("not a stream recipient")
+
+	// ErrUnsupportedVersion when stream has unknown version.
+	ErrUnsupportedVersion = internal.Error("unsupported stream version")
+
+	// ErrBadHeader when stream header can't be decoded.
+	ErrBadHeader = internal.Error("bad stream header")
+
+	// ErrDecrypt when chunk can't be authenticated: it is modified,
+	// reordered or header is modified.
+	ErrDecrypt = internal.Error("could not decrypt chunk")
+
+	// ErrTruncated when stream ends before the last chunk.
+	ErrTruncated = internal.Error("stream is truncated")
+
+	// ErrTrailingData when stream has data after the last chunk.
+	ErrTrailingData = internal.Error("trailing data after last chunk")
+
+	// ErrClosed when Write is called after Close.
+	ErrClosed = internal.Error("stream is closed")
+)
+
+// Writer encrypts data written into it and writes the stream into the
+// underlying io.Writer. Close must be called to write the last chunk.
+type Writer struct {
+	dst     io.Writer
+	aead    cipher.AEAD
+	buf     []byte
+	counter uint64
+	closed  bool
+}
+
+// NewWriter writes stream header for the given recipients into dst and returns
+// Writer encrypting payload. Sender public key is stored in the header and
+// is used to unwrap the file key.
+func NewWriter(dst io.Writer, sender *ecdsa.PrivateKey, recipients ...*ecdsa.PublicKey) (*Writer, error) {
+	if sender == nil || sender.D == nil {
+		return nil, crypto.ErrEmptyPrivateKey
+	} else if len(recipients) == 0 {
+		return nil, ErrNoRecipients
+	} else if len(recipients) > MaxRecipients {
+		return nil, fmt.Errorf("%w: %d recipients, max %d", ErrBadHeader, len(recipients), MaxRecipients)
+	}
+
+	fileKey := make([]byte, fileKeySize)
+	defer internal.WipeBytes(fileKey)
+
+	salt := make([]byte, saltSize)
+
+	if _, err := rand.Read(fileKey); err != nil {
+		return nil, err
+	} else if _, err = rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	senderPub := crypto.MarshalPublicKey(&sender.PublicKey)
+
+	header := make([]byte, 0, fixedHeader+len(recipients)*stanzaSize)
+	header = append(header, Version)
+	header = append(header, senderPub...)
+	header = append(header, salt...)
+	header = binary.BigEndian.AppendUint16(header, uint16(len(recipients)))
+
+	for _, pub := range recipients {
+		recipientPub := crypto.MarshalPublicKey(pub)
+		if recipientPub == nil {
+			return nil, crypto.ErrEmptyPublicKey
+		}
+
+		wrap, err := newWrapAEAD(sender, pub, salt, senderPub, recipientPub)
+		if err != nil {
+			return nil, err
+		}
+
+		header = append(header, recipientPub...)
+		header = wrap.Seal(header, make([]byte, nonceSize), fileKey, nil)
+	}
+
+	aead, err := newPayloadAEAD(fileKey, header)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = dst.Write(header); err != nil {
+		return nil, err
+	}
+
+	return &Writer{
+		dst:  dst,
+		aead: aead,
+		buf:  make([]byte, 0, encChunkSize),
+	}, nil
+}
+
+// Write encrypts p. Full chunks are written into underlying writer only when
+// the next chunk begins, because the last chunk is sealed differently.
+func (w *Writer) Write(p []byte) (int, error) {
+	if w.closed {
+		return 0, ErrClosed
+	}
+
+	var n int
+
+	for len(p) > 0 {
+		if len(w.buf) == ChunkSize {
+			if err := w.flush(false); err != nil {
+				return n, err
+			}
+		}
+
+		l := copy(w.buf[len(w.buf):ChunkSize], p)
+		w.buf = w.buf[:len(w.buf)+l]
+		p = p[l:]
+		n += l
+	}
+
+	return n, nil
+}
+
+// Close writes the last chunk. It doesn't close the underlying writer.
+func (w *Writer) Close() error {
+	if w.closed {
+		return ErrClosed
+	}
+
+	w.closed = true
+
+	return w.flush(true)
+}
+
+func (w *Writer) flush(last bool) error {
+	sealed := w.aead.Seal(w.buf[:0], chunkNonce(w.counter, last), w.buf, nil)
+	w.counter++
+
+	_, err := w.dst.Write(sealed)
+	w.buf = w.buf[:0]
+
+	return err
+}
+
+// Reader decrypts the stream read from the underlying io.Reader.
+type Reader struct {
+	src     io.Reader
+	aead    cipher.AEAD
+	sender  *ecdsa.PublicKey
+	buf     []byte
+	out     []byte
+	plain   []byte
+	counter uint64
+	last    bool
+	err     error
+}
+
+// NewReader reads stream header from src and unwraps file key using
+// recipient private key.
+func NewReader(src io.Reader, recipient *ecdsa.PrivateKey) (*Reader, error) {
+	if recipient == nil || recipient.D == nil {
+		return nil, crypto.ErrEmptyPrivateKey
+	}
+
+	header := make([]byte, fixedHeader)
+	if _, err := io.ReadFull(src, header); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBadHeader, err)
+	} else if header[0] != Version {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, header[0])
+	}
+
+	var (
+		senderPub = header[1 : 1+crypto.PublicKeyCompressedSize]
+		salt      = header[1+crypto.PublicKeyCompressedSize : fixedHeader-2]
+		count     = int(binary.BigEndian.Uint16(header[fixedHeader-2:]))
+	)
+
+	sender := crypto.UnmarshalPublicKey(senderPub)
+	if sender == nil {
+		return nil, fmt.Errorf("%w: bad sender key", ErrBadHeader)
+	} else if count == 0 {
+		return nil, fmt.Errorf("%w: %w", ErrBadHeader, ErrNoRecipients)
+	}
+
+	header = append(header, make([]byte, count*stanzaSize)...)
+	if _, err := io.ReadFull(src, header[fixedHeader:]); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBadHeader, err)
+	}
+
+	// slices of header may be moved by append above, so take them again
+	senderPub = header[1 : 1+crypto.PublicKeyCompressedSize]
+	salt = header[1+crypto.PublicKeyCompressedSize : fixedHeader-2]
+
+	recipientPub := crypto.MarshalPublicKey(&recipient.PublicKey)
+	fileKey, err := unwrapFileKey(header[fixedHeader:], recipient, sender, salt, senderPub, recipientPub)
+	if err != nil {
+		return nil, err
+	}
+
+	defer internal.WipeBytes(fileKey)
+
+	aead, err := newPayloadAEAD(fileKey, header)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reader{
+		src:    src,
+		aead:   aead,
+		sender: sender,
+		buf:    make([]byte, encChunkSize),
+		out:    make([]byte, 0, ChunkSize),
+	}, nil
+}
+
+func unwrapFileKey(stanzas []byte, recipient *ecdsa.PrivateKey, sender *ecdsa.PublicKey,
+	salt, senderPub, recipientPub []byte) ([]byte, error) {
+	for ; len(stanzas) > 0; stanzas = stanzas[stanzaSize:] {
+		if !bytes.Equal(stanzas[:crypto.PublicKeyCompressedSize], recipientPub) {
+			continue
+		}
+
+		wrap, err := newWrapAEAD(recipient, sender, salt, senderPub, recipientPub)
+		if err != nil {
+			return nil, err
+		}
+
+		fileKey, err := wrap.Open(nil, make([]byte, nonceSize), stanzas[crypto.PublicKeyCompressedSize:stanzaSize], nil)
+		if err != nil {
+			return nil, fmt.Errorf("%w: could not unwrap file key", ErrNotRecipient)
+		}
+
+		return fileKey, nil
+	}
+
+	return nil, ErrNotRecipient
+}
+
+// Sender returns public key of the stream sender from the header. For
+// streams with multiple recipients it doesn't prove the payload origin, see
+// package documentation.
+func (r *Reader) Sender() *ecdsa.PublicKey {
+	return r.sender
+}
+
+// Read decrypts stream into p.
+func (r *Reader) Read(p []byte) (int, error) {
+	for len(r.plain) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+
+		r.err = r.readChunk()
+	}
+
+	n := copy(p, r.plain)
+	r.plain = r.plain[n:]
+
+	return n, nil
+}
+
+func (r *Reader) readChunk() error {
+	if r.last {
+		return io.EOF
+	}
+
+	n, err := io.ReadFull(r.src, r.buf)
+	switch {
+	case errors.Is(err, io.EOF):
+		return ErrTruncated
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		// short chunk can be only the last one
+		r.last = true
+	case err != nil:
+		return err
+	}
+
+	chunk := r.buf[:n]
+
+	// GCM wipes output on failure, so ciphertext is not decrypted in place
+	plain, err := r.aead.Open(r.out[:0], chunkNonce(r.counter, r.last), chunk, nil)
+	if err != nil && !r.last {
+		// full-size chunk may be the last one too
+		r.last = true
+		plain, err = r.aead.Open(r.out[:0], chunkNonce(r.counter, true), chunk, nil)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w: #%d", ErrDecrypt, r.counter)
+	}
+
+	r.counter++
+	r.plain = plain
+
+	if r.last && n == encChunkSize {
+		var b [1]byte
+		if m, _ := r.src.Read(b[:]); m != 0 {
+			return ErrTrailingData
+		}
+	}
+
+	return nil
+}
+
+// chunkNonce returns STREAM nonce: 11-byte big-endian counter and last chunk flag.
+func chunkNonce(counter uint64, last bool) []byte {
+	nonce := make([]byte, nonceSize)
+	binary.BigEndian.PutUint64(nonce[3:11], counter)
+
+	if last {
+		nonce[nonceSize-1] = lastChunkFlag
+	}
+
+	return nonce
+}
+
+// newWrapAEAD returns AES-256-GCM for file key wrapping. Key is derived by
+// ECDH between sender and recipient and bound to stream salt, so it is unique
+// for every stream and zero nonce is safe.
+func newWrapAEAD(priv *ecdsa.PrivateKey, pub *ecdsa.PublicKey, salt, senderPub, recipientPub []byte) (cipher.AEAD, error) {
+	info := make([]byte, 0, len(wrapInfo)+saltSize+2*crypto.PublicKeyCompressedSize)
+	info = append(info, wrapInfo...)
+	info = append(info, salt...)
+	info = append(info, senderPub...)
+	info = append(info, recipientPub...)
+
+	key, err := crypto.SharedSecret(priv, pub, info)
+	if err != nil {
+		return nil, err
+	}
+
+	defer internal.WipeBytes(key)
+
+	return newGCM(key)
+}
+
+// newPayloadAEAD returns AES-256-GCM for payload chunks, key is derived from
+// file key and header hash.
+func newPayloadAEAD(fileKey, header []byte) (cipher.AEAD, error) {
+	sum := sha256.Sum256(header)
+	key := make([]byte, fileKeySize)
+
+	defer internal.WipeBytes(key)
+
+	if _, err := io.ReadFull(hkdf.New(sha256.New, fileKey, sum[:], []byte(payloadInfo)), key); err != nil {
+		return nil, err
+	}
+
+	return newGCM(key)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
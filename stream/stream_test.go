@@ -0,0 +1,173 @@
+package stream
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"io"
+	"testing"
+
+	crypto "github.com/nspcc-dev/neofs-crypto"
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/stretchr/testify/require"
+)
+
+func encrypt(t *testing.T, payload []byte, recipients ...int) []byte {
+	var (
+		buf  bytes.Buffer
+		pubs = make([]*ecdsa.PublicKey, 0, len(recipients))
+	)
+
+	for _, i := range recipients {
+		pubs = append(pubs, &test.DecodeKey(i).PublicKey)
+	}
+
+	w, err := NewWriter(&buf, test.DecodeKey(0), pubs...)
+	require.NoError(t, err)
+
+	// write in uneven pieces to cross chunk boundaries
+	for p := payload; len(p) > 0; {
+		n := 1000
+		if n > len(p) {
+			n = len(p)
+		}
+
+		_, err = w.Write(p[:n])
+		require.NoError(t, err)
+		p = p[n:]
+	}
+
+	require.NoError(t, w.Close())
+
+	return buf.Bytes()
+}
+
+func decrypt(data []byte, recipient int) ([]byte, error) {
+	r, err := NewReader(bytes.NewReader(data), test.DecodeKey(recipient))
+	if err != nil {
+		return nil, err
+	}
+
+	return io.ReadAll(r)
+}
+
+func randomPayload(t *testing.T, size int) []byte {
+	payload := make([]byte, size)
+	_, err := rand.Read(payload)
+	require.NoError(t, err)
+
+	return payload
+}
+
+func TestStream(t *testing.T) {
+	for _, size := range []int{0, 1, ChunkSize - 1, ChunkSize, ChunkSize + 1, 3*ChunkSize + 17, 2 * ChunkSize} {
+		payload := randomPayload(t, size)
+		data := encrypt(t, payload, 1, 2, 3)
+
+		for _, recipient := range []int{1, 2, 3} {
+			actual, err := decrypt(data, recipient)
+			require.NoError(t, err, "size %d", size)
+			require.True(t, bytes.Equal(payload, actual), "size %d", size)
+		}
+
+		_, err := decrypt(data, 4)
+		require.ErrorIs(t, err, ErrNotRecipient)
+	}
+}
+
+func TestStreamSender(t *testing.T) {
+	data := encrypt(t, []byte("payload"), 1)
+
+	r, err := NewReader(bytes.NewReader(data), test.DecodeKey(1))
+	require.NoError(t, err)
+	require.Equal(t, crypto.MarshalPublicKey(&test.DecodeKey(0).PublicKey), crypto.MarshalPublicKey(r.Sender()))
+}
+
+func TestStreamTampering(t *testing.T) {
+	var (
+		payload = randomPayload(t, 3*ChunkSize+100)
+		data    = encrypt(t, payload, 1)
+		header  = fixedHeader + stanzaSize
+		chunk   = func(i int) []byte { return data[header+i*encChunkSize : header+(i+1)*encChunkSize] }
+	)
+
+	t.Run("truncated at chunk boundary", func(t *testing.T) {
+		_, err := decrypt(data[:header+2*encChunkSize], 1)
+		require.ErrorIs(t, err, ErrTruncated)
+	})
+
+	t.Run("truncated inside chunk", func(t *testing.T) {
+		_, err := decrypt(data[:len(data)-1], 1)
+		require.ErrorIs(t, err, ErrDecrypt)
+	})
+
+	t.Run("reordered chunks", func(t *testing.T) {
+		bad := append([]byte(nil), data[:header]...)
+		bad = append(bad, chunk(1)...)
+		bad = append(bad, chunk(0)...)
+		bad = append(bad, data[header+2*encChunkSize:]...)
+
+		_, err := decrypt(bad, 1)
+		require.ErrorIs(t, err, ErrDecrypt)
+	})
+
+	t.Run("dropped chunk", func(t *testing.T) {
+		bad := append([]byte(nil), data[:header]...)
+		bad = append(bad, data[header+encChunkSize:]...)
+
+		_, err := decrypt(bad, 1)
+		require.ErrorIs(t, err, ErrDecrypt)
+	})
+
+	t.Run("trailing data", func(t *testing.T) {
+		full := encrypt(t, randomPayload(t, ChunkSize), 1)
+
+		_, err := decrypt(append(full, 0), 1)
+		require.ErrorIs(t, err, ErrTrailingData)
+	})
+
+	t.Run("modified header", func(t *testing.T) {
+		bad := append([]byte(nil), data...)
+		bad[1+crypto.PublicKeyCompressedSize] ^= 1 // salt
+
+		_, err := decrypt(bad, 1)
+		require.ErrorIs(t, err, ErrNotRecipient)
+
+		bad = append([]byte{Version + 1}, data[1:]...)
+		_, err = decrypt(bad, 1)
+		require.ErrorIs(t, err, ErrUnsupportedVersion)
+
+		_, err = decrypt(data[:fixedHeader+1], 1)
+		require.ErrorIs(t, err, ErrBadHeader)
+	})
+
+	t.Run("modified chunk", func(t *testing.T) {
+		bad := append([]byte(nil), data...)
+		bad[len(bad)-1] ^= 1
+
+		r, err := NewReader(bytes.NewReader(bad), test.DecodeKey(1))
+		require.NoError(t, err)
+
+		read, err := io.ReadAll(r)
+		require.ErrorIs(t, err, ErrDecrypt)
+		require.Equal(t, payload[:len(read)], read)
+	})
+}
+
+func TestWriterErrors(t *testing.T) {
+	var buf bytes.Buffer
+
+	_, err := NewWriter(&buf, test.DecodeKey(0))
+	require.ErrorIs(t, err, ErrNoRecipients)
+
+	_, err = NewWriter(&buf, nil, &test.DecodeKey(1).PublicKey)
+	require.ErrorIs(t, err, crypto.ErrEmptyPrivateKey)
+
+	w, err := NewWriter(&buf, test.DecodeKey(0), &test.DecodeKey(1).PublicKey)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	_, err = w.Write([]byte{1})
+	require.ErrorIs(t, err, ErrClosed)
+	require.ErrorIs(t, w.Close(), ErrClosed)
+}
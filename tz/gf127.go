@@ -0,0 +1,98 @@
+package tz
+
+import "encoding/binary"
+
+// gf127 is an element of GF(2^127) defined by x^127 + x^63 + 1 polynomial.
+// Word 0 holds coefficients of x^0..x^63, word 1 holds x^64..x^126.
+type gf127 [2]uint64
+
+const (
+	gf127Size = 16
+	msb64     = uint64(1) << 63
+)
+
+// add sets c = a + b.
+func add(a, b, c *gf127) {
+	c[0] = a[0] ^ b[0]
+	c[1] = a[1] ^ b[1]
+}
+
+// mul10 sets b = a·x.
+func mul10(a, b *gf127) {
+	c := a[0] >> 63
+	b[0] = a[0] << 1
+	b[1] = a[1]<<1 | c
+
+	if b[1]&msb64 != 0 { // x^127 = x^63 + 1
+		b[1] ^= msb64
+		b[0] ^= msb64 | 1
+	}
+}
+
+// mul11 sets b = a·(x+1).
+func mul11(a, b *gf127) {
+	var t gf127
+
+	mul10(a, &t)
+	add(a, &t, b)
+}
+
+// mulPure sets c = a·b using shift-and-add multiplication.
+func mulPure(a, b, c *gf127) {
+	var (
+		r gf127
+		t = *a
+	)
+
+	for i := 0; i < 127; i++ {
+		if b[i/64]>>(i%64)&1 != 0 {
+			add(&r, &t, &r)
+		}
+
+		mul10(&t, &t)
+	}
+
+	*c = r
+}
+
+// reduce returns 256-bit carry-less product p (degree <= 252) modulo
+// x^127 + x^63 + 1.
+func reduce(p *[4]uint64) gf127 {
+	// p = H·x^127 + L
+	var (
+		h0 = p[1]>>63 | p[2]<<1
+		h1 = p[2]>>63 | p[3]<<1
+		l0 = p[0]
+		l1 = p[1] &^ msb64
+	)
+
+	// H·x^63 = s2·x^128 + s1·x^64 + s0, parts above x^126 are reduced again
+	var (
+		s0 = h0 << 63
+		s1 = h0>>1 | h1<<63
+		s2 = h1 >> 1
+		o  = s1>>63 | s2<<1
+	)
+
+	s1 &^= msb64
+
+	return gf127{
+		l0 ^ h0 ^ s0 ^ o ^ o<<63,
+		l1 ^ h1 ^ s1 ^ o>>1,
+	}
+}
+
+// marshal writes big-endian representation of a into buf.
+func (a *gf127) marshal(buf []byte) {
+	binary.BigEndian.PutUint64(buf, a[1])
+	binary.BigEndian.PutUint64(buf[8:], a[0])
+}
+
+// unmarshal reads big-endian representation of a from buf.
+// It returns false if x^127 coefficient is set.
+func (a *gf127) unmarshal(buf []byte) bool {
+	a[1] = binary.BigEndian.Uint64(buf)
+	a[0] = binary.BigEndian.Uint64(buf[8:])
+
+	return a[1]&msb64 == 0
+}
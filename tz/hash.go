@@ -0,0 +1,190 @@
+/*
+Package tz implements Tillich–Zémor homomorphic hash used by NeoFS for object
+payload checksums. Hash is a 2x2 matrix over GF(2^127) (x^127 + x^63 + 1),
+every input bit multiplies it by one of two generator matrices, so hash of
+concatenation is a product of hashes of parts (see Concat and Validate).
+
+On amd64 processors with AVX2 and CLMUL support accelerated implementation
+is selected at runtime, otherwise pure Go one is used.
+*/
+package tz
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"hash"
+
+	"github.com/nspcc-dev/neofs-crypto/internal"
+)
+
+const (
+	// Size is the size of TZ hash in bytes.
+	Size = 4 * gf127Size
+
+	// BlockSize is the preferred size of Write calls.
+	BlockSize = 128
+
+	// ErrBadHash when hash has wrong size or isn't valid matrix.
+	ErrBadHash = internal.Error("bad tz hash")
+)
+
+// matrix is [[m[0], m[1]], [m[2], m[3]]].
+type matrix [4]gf127
+
+var identity = matrix{{1, 0}, {0, 0}, {0, 0}, {1, 0}}
+
+type digest struct {
+	m     matrix
+	write func(*matrix, []byte)
+}
+
+// New returns new hash.Hash computing TZ hash.
+func New() hash.Hash {
+	return &digest{m: identity, write: writeFunc}
+}
+
+// newPure returns hash.Hash using pure Go implementation.
+func newPure() hash.Hash {
+	return &digest{m: identity, write: writePure}
+}
+
+// Sum returns TZ hash of data.
+func Sum(data []byte) [Size]byte {
+	var (
+		res [Size]byte
+		d   = digest{m: identity, write: writeFunc}
+	)
+
+	d.write(&d.m, data)
+	d.m.marshal(res[:])
+
+	return res
+}
+
+// Write implements io.Writer.
+func (d *digest) Write(p []byte) (int, error) {
+	d.write(&d.m, p)
+	return len(p), nil
+}
+
+// Sum appends hash to b.
+func (d *digest) Sum(b []byte) []byte {
+	var res [Size]byte
+
+	d.m.marshal(res[:])
+
+	return append(b, res[:]...)
+}
+
+// Reset resets hash to initial state.
+func (d *digest) Reset() {
+	d.m = identity
+}
+
+// Size returns Size.
+func (d *digest) Size() int {
+	return Size
+}
+
+// BlockSize returns BlockSize.
+func (d *digest) BlockSize() int {
+	return BlockSize
+}
+
+// writePure multiplies m by generator matrix for every bit of data:
+// A = [[x, 1], [1, 0]] for 0 and B = [[x, x+1], [1, 1]] for 1.
+func writePure(m *matrix, data []byte) {
+	var tmp gf127
+
+	for _, b := range data {
+		for i := 7; i >= 0; i-- {
+			mulBitRight(&m[0], &m[1], &tmp, b>>i&1 != 0)
+			mulBitRight(&m[2], &m[3], &tmp, b>>i&1 != 0)
+		}
+	}
+}
+
+// mulBitRight multiplies row [c0, c1] by A or B generator.
+func mulBitRight(c0, c1, tmp *gf127, bit bool) {
+	*tmp = *c0
+	mul10(c0, c0)
+	add(c0, c1, c0)
+
+	if bit {
+		mul11(tmp, tmp)
+		add(c1, tmp, c1)
+	} else {
+		*c1 = *tmp
+	}
+}
+
+// mulMatrix sets c = a·b.
+func mulMatrix(a, b, c *matrix) {
+	var r matrix
+
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			var t1, t2 gf127
+
+			mulFunc(&a[2*i], &b[j], &t1)
+			mulFunc(&a[2*i+1], &b[2+j], &t2)
+			add(&t1, &t2, &r[2*i+j])
+		}
+	}
+
+	*c = r
+}
+
+func (m *matrix) marshal(buf []byte) {
+	for i := range m {
+		m[i].marshal(buf[i*gf127Size:])
+	}
+}
+
+func (m *matrix) unmarshal(buf []byte) error {
+	if len(buf) != Size {
+		return fmt.Errorf("%w: actual=%d, expect=%d", ErrBadHash, len(buf), Size)
+	}
+
+	for i := range m {
+		if !m[i].unmarshal(buf[i*gf127Size:]) {
+			return fmt.Errorf("%w: element #%d is out of field", ErrBadHash, i)
+		}
+	}
+
+	return nil
+}
+
+// Concat returns hash of concatenation of data which hashes are passed.
+func Concat(hashes [][]byte) ([]byte, error) {
+	res := identity
+
+	for i := range hashes {
+		var m matrix
+		if err := m.unmarshal(hashes[i]); err != nil {
+			return nil, err
+		}
+
+		mulMatrix(&res, &m, &res)
+	}
+
+	buf := make([]byte, Size)
+	res.marshal(buf)
+
+	return buf, nil
+}
+
+// Validate checks that h is a hash of concatenation of data which hashes
+// are passed.
+func Validate(h []byte, hashes [][]byte) (bool, error) {
+	if len(h) != Size {
+		return false, fmt.Errorf("%w: actual=%d, expect=%d", ErrBadHash, len(h), Size)
+	}
+
+	expected, err := Concat(hashes)
+	if err != nil {
+		return false, err
+	}
+
+	return subtle.ConstantTimeCompare(h, expected) == 1, nil
+}
@@ -0,0 +1,285 @@
+package tz
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"hash"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func randomBytes(t testing.TB, size int) []byte {
+	data := make([]byte, size)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+
+	return data
+}
+
+func TestEmpty(t *testing.T) {
+	one := strings.Repeat("00", gf127Size-1) + "01"
+	zero := strings.Repeat("00", gf127Size)
+
+	h := Sum(nil)
+	require.Equal(t, one+zero+zero+one, hex.EncodeToString(h[:]))
+}
+
+// Test vectors are taken from github.com/nspcc-dev/tzhash to keep hashes
+// compatible with existing NeoFS data.
+var testCases = []struct {
+	input []byte
+	hash  string
+}{
+	{
+		[]byte{},
+		"00000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001",
+	},
+	{
+		[]byte{0},
+		"00000000000000000000000000000151000000000000000000000000000000800000000000000000000000000000008000000000000000000000000000000051",
+	},
+	{
+		[]byte{1, 2},
+		"000000000000000000000000000139800000000000000000000000000000c0010000000000000000000000000000b98100000000000000000000000000007981",
+	},
+	{
+		[]byte{2, 0, 1},
+		"00000000000000000000000001f980d10000000000000000000000000139805100000000000000000000000000c001d100000000000000000000000000b98080",
+	},
+	{
+		[]byte{3, 2, 1, 0},
+		"0000000000000000000000015540398000000000000000000000000082a1a88100000000000000000000000082a1d10100000000000000000000000050006881",
+	},
+	{
+		[]byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF},
+		"0000000000000000000001bb00ba00ba000000000000000000000101010101010000000000000000000000ff00ff00ff0000000000000000000000ba01bb01bb",
+	},
+	{
+		[]byte{0xAA, 0xAA, 0xAA, 0xAA, 0xAA, 0xAA},
+		"000000000000000000016ad06ad16bd100000000000000000000ff00ff00ff0000000000000000000000808080808080000000000000000000006bd16bd06ad1",
+	},
+	{
+		[]byte{0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55},
+		"0000000000000000018c8c118d9d009d00000000000000000169680169680168000000000000000000f0f000f0f000f00000000000000000009d9c109c8d018d",
+	},
+	{
+		[]byte{0, 1, 2, 3, 4, 5, 6, 7, 8},
+		"00000000000001e4a545e5b90fb6882b00000000000000c849cd88f79307f67100000000000000cd0c898cb68356e624000000000000007cbcdc7c5e89b16e4b",
+	},
+	{
+		[]byte{4, 8, 15, 16, 23, 42, 255, 0, 127, 65, 32, 123, 42, 45, 201, 210, 213, 244},
+		"4db8a8e253903c70ab0efb65fe6de05a36d1dc9f567a147152d0148a86817b2062908d9b026a506007c1118e86901b672a39317c55ee3c10ac8efafa79efe8ee",
+	},
+}
+
+var testCaseConcat = struct {
+	hash  string
+	parts []string
+}{
+	hash: "7f5c9280352a8debea738a74abd4ec787f2c5e556800525692f651087442f9883bb97a2c1bc72d12ba26e3df8dc0f670564292ebc984976a8e353ff69a5fb3cb",
+	parts: []string{
+		"4275945919296224acd268456be23b8b2df931787a46716477e32cd991e98074029d4f03a0fedc09125ee4640d228d7d40d430659a0b2b70e9cd4d4c5361865a",
+		"2828661d1b1e77f21788d3b365f140a2395d57dc2083c33e60d9a80e69017d5016a249c7adfe1718a10ba887dedbdaec5c4c1fbecdb1f98776b43f1142c26a88",
+		"02310598b45dfa77db9f00eed6ab60773dd8bed7bdac431b42e441fae463f64c6e2688402cfdcec5def47a299b0651fb20878cf4410991bd57056d7b4b31635a",
+		"1ed7e0b065c060d915e7355cdcb4edc752c06d2a4b39d90c8985aeb58e08cb9e5bbe4b2b45524efbd68cd7e4081a1b8362941200a4c9f76a0a9f9ac9b7868c03",
+		"6f11e3dc4fff99ffa45e36e4655cfc657c29e950e598a90f426bf5710de9171323523db7636643b23892783f4fb3cf8e583d584c82d29558a105a615a668fc9e",
+		"1865dbdb4c849620fb2c4809d75d62490f83c11f2145abaabbdc9a66ae58ce1f2e42c34d3b380e5dea1b45217750b42d130f995b162afbd2e412b0d41ec8871b",
+		"5102dd1bd1f08f44dbf3f27ac895020d63f96044ce3b491aed3efbc7bbe363bc5d800101d63890f89a532427812c30c9674f37476ba44daf758afa88d4f91063",
+		"70cab735dad90164cc61f7411396221c4e549f12392c0d77728c89a9754f606c7d961169d4fa88133a1ba954bad616656c86f8fd1335a2f3428fd4dca3a3f5a5",
+		"430f3e92536ff9a50cbcdf08d8810a59786ca37e31d54293646117a93469f61c6cdd67933128407d77f3235293293ee86dbc759d12dfe470969eba1b4a373bd0",
+		"46e1d97912ca2cf92e6a9a63667676835d900cdb2fff062136a64d8d60a8e5aa644ccee3558900af8e77d56b013ed5da12d9d0b7de0f56976e040b3d01345c0d",
+	},
+}
+
+func TestKnownAnswers(t *testing.T) {
+	for _, tc := range testCases {
+		sum := Sum(tc.input)
+		require.Equal(t, tc.hash, hex.EncodeToString(sum[:]), "input %x", tc.input)
+
+		for _, h := range []hash.Hash{New(), newPure()} {
+			for i := range tc.input {
+				_, err := h.Write(tc.input[i : i+1])
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.hash, hex.EncodeToString(h.Sum(nil)), "input %x", tc.input)
+		}
+	}
+
+	t.Run("large input", func(t *testing.T) {
+		// computed by github.com/nspcc-dev/tzhash v1.8.3 tz.Sum
+		const expected = "25f09f3c495f701300bae4d319d7f4a24adab3b574dc44a749361b234b7de184" +
+			"2405baae1b47fc56f0c27b608a96a9c023c1a6c1a4b698855778890eb9f4261a"
+
+		data := bytes.Repeat([]byte{0x01, 0x02, 0x03, 0x04, 0x05}, 1<<20/5)
+
+		sum := Sum(data)
+		require.Equal(t, expected, hex.EncodeToString(sum[:]))
+
+		pure := newPure()
+		_, err := pure.Write(data)
+		require.NoError(t, err)
+		require.Equal(t, expected, hex.EncodeToString(pure.Sum(nil)))
+	})
+
+	t.Run("concat", func(t *testing.T) {
+		expected, err := hex.DecodeString(testCaseConcat.hash)
+		require.NoError(t, err)
+
+		parts := make([][]byte, len(testCaseConcat.parts))
+		for i := range parts {
+			parts[i], err = hex.DecodeString(testCaseConcat.parts[i])
+			require.NoError(t, err)
+		}
+
+		actual, err := Concat(parts)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+
+		ok, err := Validate(expected, parts)
+		require.NoError(t, err)
+		require.True(t, ok)
+	})
+}
+
+func TestImplementations(t *testing.T) {
+	for _, size := range []int{1, 2, 31, 64, 1000, 4096} {
+		data := randomBytes(t, size)
+
+		pure := newPure()
+		_, err := pure.Write(data)
+		require.NoError(t, err)
+
+		h := New()
+		_, err = h.Write(data[:size/2])
+		require.NoError(t, err)
+		_, err = h.Write(data[size/2:])
+		require.NoError(t, err)
+
+		expected := Sum(data)
+		require.Equal(t, expected[:], pure.Sum(nil), "size %d", size)
+		require.Equal(t, expected[:], h.Sum(nil), "size %d", size)
+	}
+}
+
+func TestMul(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		var a, b, expected, actual gf127
+
+		data := randomBytes(t, 2*gf127Size)
+		data[0] &= 0x7f
+		data[gf127Size] &= 0x7f
+		require.True(t, a.unmarshal(data))
+		require.True(t, b.unmarshal(data[gf127Size:]))
+
+		mulPure(&a, &b, &expected)
+		mulFunc(&a, &b, &actual)
+		require.Equal(t, expected, actual)
+
+		var p [4]uint64
+		p[0], p[1] = expected[0], expected[1]
+		require.Equal(t, expected, reduce(&p))
+	}
+
+	t.Run("x^126·x", func(t *testing.T) {
+		var (
+			a = gf127{0, 1 << 62}
+			x = gf127{2, 0}
+			c gf127
+		)
+
+		mulFunc(&a, &x, &c)
+		require.Equal(t, gf127{msb64 | 1, 0}, c)
+
+		mul10(&a, &c)
+		require.Equal(t, gf127{msb64 | 1, 0}, c)
+	})
+}
+
+func TestHomomorphism(t *testing.T) {
+	var (
+		a = randomBytes(t, 300)
+		b = randomBytes(t, 1)
+		c = randomBytes(t, 4000)
+
+		ha, hb, hc = Sum(a), Sum(b), Sum(c)
+		full       = Sum(append(append(append([]byte(nil), a...), b...), c...))
+	)
+
+	actual, err := Concat([][]byte{ha[:], hb[:], hc[:]})
+	require.NoError(t, err)
+	require.Equal(t, full[:], actual)
+
+	ok, err := Validate(full[:], [][]byte{ha[:], hb[:], hc[:]})
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = Validate(full[:], [][]byte{hb[:], ha[:], hc[:]})
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, err = Validate(full[1:], [][]byte{ha[:]})
+	require.ErrorIs(t, err, ErrBadHash)
+
+	_, err = Concat([][]byte{ha[:5]})
+	require.ErrorIs(t, err, ErrBadHash)
+
+	bad := ha
+	bad[0] = 0x80
+	_, err = Concat([][]byte{bad[:]})
+	require.ErrorIs(t, err, ErrBadHash)
+}
+
+func TestDeterminant(t *testing.T) {
+	// generators are in SL(2), so det = m0·m3 + m1·m2 = 1
+	h := Sum(randomBytes(t, 100))
+
+	var (
+		m      matrix
+		t1, t2 gf127
+	)
+
+	require.NoError(t, m.unmarshal(h[:]))
+
+	mulPure(&m[0], &m[3], &t1)
+	mulPure(&m[1], &m[2], &t2)
+	add(&t1, &t2, &t1)
+	require.Equal(t, gf127{1, 0}, t1)
+}
+
+func TestHashInterface(t *testing.T) {
+	h := New()
+	require.Equal(t, Size, h.Size())
+	require.Equal(t, BlockSize, h.BlockSize())
+
+	_, err := h.Write([]byte("data"))
+	require.NoError(t, err)
+	h.Reset()
+
+	empty := Sum(nil)
+	require.Equal(t, empty[:], h.Sum(nil))
+}
+
+func BenchmarkSum(b *testing.B) {
+	data := randomBytes(b, 64*1024)
+
+	b.Run("pure", func(b *testing.B) {
+		b.SetBytes(int64(len(data)))
+
+		for i := 0; i < b.N; i++ {
+			h := newPure()
+			_, _ = h.Write(data)
+		}
+	})
+
+	b.Run("default", func(b *testing.B) {
+		b.SetBytes(int64(len(data)))
+
+		for i := 0; i < b.N; i++ {
+			_ = Sum(data)
+		}
+	})
+}
@@ -0,0 +1,38 @@
+//go:build amd64 && !purego
+
+package tz
+
+import "golang.org/x/sys/cpu"
+
+var (
+	writeFunc = writePure
+	mulFunc   = mulPure
+)
+
+func init() {
+	if cpu.X86.HasAVX2 {
+		writeFunc = writeAVX2
+	}
+
+	if cpu.X86.HasPCLMULQDQ {
+		mulFunc = mulCLMUL
+	}
+}
+
+// writeAVX2 is writePure processing both matrix rows in parallel in YMM registers.
+//
+//go:noescape
+func writeAVX2(m *matrix, data []byte)
+
+// clmul sets r to 256-bit carry-less product of a and b.
+//
+//go:noescape
+func clmul(a, b *gf127, r *[4]uint64)
+
+// mulCLMUL sets c = a·b using PCLMULQDQ instruction.
+func mulCLMUL(a, b, c *gf127) {
+	var p [4]uint64
+
+	clmul(a, b, &p)
+	*c = reduce(&p)
+}
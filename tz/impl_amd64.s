@@ -0,0 +1,106 @@
+//go:build amd64 && !purego
+
+#include "textflag.h"
+
+// Reduction constant for both 128-bit lanes: x^127 -> x^63 + 1.
+DATA reduceMask<>+0(SB)/8, $0x8000000000000001
+DATA reduceMask<>+8(SB)/8, $0x8000000000000000
+DATA reduceMask<>+16(SB)/8, $0x8000000000000001
+DATA reduceMask<>+24(SB)/8, $0x8000000000000000
+GLOBL reduceMask<>(SB), RODATA|NOPTR, $32
+
+// func writeAVX2(m *matrix, data []byte)
+TEXT ·writeAVX2(SB), NOSPLIT, $0-32
+	MOVQ m+0(FP), AX
+	MOVQ data_base+8(FP), SI
+	MOVQ data_len+16(FP), DX
+
+	// Y0 = [m0 | m2], Y1 = [m1 | m3]
+	VMOVDQU     (AX), X0
+	VINSERTI128 $1, 32(AX), Y0, Y0
+	VMOVDQU     16(AX), X1
+	VINSERTI128 $1, 48(AX), Y1, Y1
+
+	VMOVDQU reduceMask<>(SB), Y8
+	VPXOR   Y9, Y9, Y9
+
+	TESTQ DX, DX
+	JZ    done
+
+loop:
+	MOVBQZX (SI), BX
+	MOVQ    $7, CX
+
+bit:
+	// Y10 = all ones if bit is set
+	MOVQ         BX, R8
+	SHRQ         CX, R8
+	ANDQ         $1, R8
+	NEGQ         R8
+	VMOVQ        R8, X10
+	VPBROADCASTQ X10, Y10
+
+	// Y2 = Y0·x
+	VPSLLQ  $1, Y0, Y2
+	VPSRLQ  $63, Y0, Y3
+	VPSLLDQ $8, Y3, Y3
+	VPXOR   Y3, Y2, Y2
+	VPSRLQ  $63, Y2, Y3
+	VPSHUFD $0xee, Y3, Y3
+	VPSUBQ  Y3, Y9, Y3
+	VPAND   Y8, Y3, Y3
+	VPXOR   Y3, Y2, Y2
+
+	// c0' = c0·x + c1
+	// c1' = c0 for A and c0·(x+1) + c1 = c0 + c0' for B
+	VPXOR   Y1, Y2, Y2
+	VPAND   Y10, Y2, Y4
+	VPXOR   Y0, Y4, Y1
+	VMOVDQA Y2, Y0
+
+	DECQ CX
+	JGE  bit
+
+	INCQ SI
+	DECQ DX
+	JNZ  loop
+
+done:
+	VMOVDQU      X0, (AX)
+	VEXTRACTI128 $1, Y0, 32(AX)
+	VMOVDQU      X1, 16(AX)
+	VEXTRACTI128 $1, Y1, 48(AX)
+	VZEROUPPER
+	RET
+
+// func clmul(a, b *gf127, r *[4]uint64)
+TEXT ·clmul(SB), NOSPLIT, $0-24
+	MOVQ a+0(FP), AX
+	MOVQ b+8(FP), BX
+	MOVQ r+16(FP), CX
+
+	MOVOU (AX), X0
+	MOVOU (BX), X1
+
+	// X2 = a0·b0, X3 = a1·b1
+	MOVOU     X0, X2
+	PCLMULQDQ $0x00, X1, X2
+	MOVOU     X0, X3
+	PCLMULQDQ $0x11, X1, X3
+
+	// X4 = a1·b0 + a0·b1
+	MOVOU     X0, X4
+	PCLMULQDQ $0x01, X1, X4
+	MOVOU     X0, X5
+	PCLMULQDQ $0x10, X1, X5
+	PXOR      X5, X4
+
+	MOVOU  X4, X5
+	PSLLDQ $8, X5
+	PSRLDQ $8, X4
+	PXOR   X5, X2
+	PXOR   X4, X3
+
+	MOVOU X2, (CX)
+	MOVOU X3, 16(CX)
+	RET
@@ -0,0 +1,8 @@
+//go:build !amd64 || purego
+
+package tz
+
+var (
+	writeFunc = writePure
+	mulFunc   = mulPure
+)
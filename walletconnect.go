@@ -0,0 +1,94 @@
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+)
+
+const (
+	// WalletConnectSaltSize is a size of random salt of WalletConnect signature.
+	WalletConnectSaltSize = 16
+
+	// WalletConnectSignatureSize contains r and s coordinates followed by salt.
+	WalletConnectSignatureSize = RFC6979SignatureSize + WalletConnectSaltSize
+)
+
+// walletConnectPrefix starts every message signed by Neo wallets via WalletConnect.
+var walletConnectPrefix = []byte{0x01, 0x00, 0x01, 0xf0}
+
+// SignWalletConnect signs msg the same way Neo wallets do via WalletConnect
+// API and NeoFS expects it: msg is base64-encoded, salted by random
+// 16 bytes and signed by SignRFC6979Hash. It returns r||s||salt.
+func SignWalletConnect(key *ecdsa.PrivateKey, msg []byte) ([]byte, error) {
+	if key == nil {
+		return nil, ErrEmptyPrivateKey
+	}
+
+	var salt [WalletConnectSaltSize]byte
+	if _, err := rand.Read(salt[:]); err != nil {
+		return nil, fmt.Errorf("could not generate salt: %w", err)
+	}
+
+	sig, err := SignRFC6979Hash(key, walletConnectHash(msg, salt[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	return append(sig, salt[:]...), nil
+}
+
+// VerifyWalletConnect verifies r||s||salt signature of msg created by
+// SignWalletConnect or Neo wallet. It returns nil only if signature is valid.
+func VerifyWalletConnect(key *ecdsa.PublicKey, msg, sig []byte) error {
+	if key == nil {
+		return ErrEmptyPublicKey
+	} else if ln := len(sig); ln != WalletConnectSignatureSize {
+		return fmt.Errorf("%w: actual=%d, expect=%d",
+			ErrWrongHashSize, ln, WalletConnectSignatureSize)
+	}
+
+	r, s, err := decodeSignature(sig[:RFC6979SignatureSize])
+	if err != nil {
+		return err
+	} else if !ecdsa.Verify(key, walletConnectHash(msg, sig[RFC6979SignatureSize:]), r, s) {
+		return ErrWrongSignature
+	}
+
+	return nil
+}
+
+// walletConnectHash returns SHA-256 of the salted message:
+// prefix || varint(len) || hex(salt) || base64(msg) || 0x0000.
+func walletConnectHash(msg, salt []byte) []byte {
+	ln := hex.EncodedLen(len(salt)) + base64.StdEncoding.EncodedLen(len(msg))
+
+	data := make([]byte, 0, len(walletConnectPrefix)+binary.MaxVarintLen64+ln+2)
+	data = append(data, walletConnectPrefix...)
+	data = appendVarUint(data, uint64(ln))
+	data = append(data, hex.EncodeToString(salt)...)
+	data = append(data, base64.StdEncoding.EncodeToString(msg)...)
+	data = append(data, 0x00, 0x00)
+
+	sum := sha256.Sum256(data)
+
+	return sum[:]
+}
+
+// appendVarUint appends Neo variable-length encoding of n.
+func appendVarUint(buf []byte, n uint64) []byte {
+	switch {
+	case n < 0xfd:
+		return append(buf, byte(n))
+	case n <= 0xffff:
+		return binary.LittleEndian.AppendUint16(append(buf, 0xfd), uint16(n))
+	case n <= 0xffffffff:
+		return binary.LittleEndian.AppendUint32(append(buf, 0xfe), uint32(n))
+	default:
+		return binary.LittleEndian.AppendUint64(append(buf, 0xff), n)
+	}
+}
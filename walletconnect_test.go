@@ -0,0 +1,61 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWalletConnect(t *testing.T) {
+	key := test.DecodeKey(1)
+	msg := []byte("Hello, NeoFS!")
+
+	sig, err := SignWalletConnect(key, msg)
+	require.NoError(t, err)
+	require.Len(t, sig, WalletConnectSignatureSize)
+	require.NoError(t, VerifyWalletConnect(&key.PublicKey, msg, sig))
+
+	other, err := SignWalletConnect(key, msg)
+	require.NoError(t, err)
+	require.NotEqual(t, sig[RFC6979SignatureSize:], other[RFC6979SignatureSize:], "salt must be random")
+	require.NoError(t, VerifyWalletConnect(&key.PublicKey, msg, other))
+
+	t.Run("payload", func(t *testing.T) {
+		salt, err := hex.DecodeString("3da1f339213180ed4c46a12b4a9b2b4e")
+		require.NoError(t, err)
+
+		payload := []byte{0x01, 0x00, 0x01, 0xf0, 0x34}
+		payload = append(payload, "3da1f339213180ed4c46a12b4a9b2b4e"...)
+		payload = append(payload, "SGVsbG8sIE5lb0ZTIQ=="...)
+		payload = append(payload, 0x00, 0x00)
+
+		expected := sha256.Sum256(payload)
+		require.Equal(t, expected[:], walletConnectHash(msg, salt))
+	})
+
+	t.Run("var uint", func(t *testing.T) {
+		require.Equal(t, []byte{0xfc}, appendVarUint(nil, 0xfc))
+		require.Equal(t, []byte{0xfd, 0xfd, 0x00}, appendVarUint(nil, 0xfd))
+		require.Equal(t, []byte{0xfd, 0xff, 0xff}, appendVarUint(nil, 0xffff))
+		require.Equal(t, []byte{0xfe, 0x00, 0x00, 0x01, 0x00}, appendVarUint(nil, 0x10000))
+		require.Equal(t, []byte{0xff, 0, 0, 0, 0, 1, 0, 0, 0}, appendVarUint(nil, 0x100000000))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		require.ErrorIs(t, VerifyWalletConnect(&key.PublicKey, []byte("Hello, NeoFS?"), sig), ErrWrongSignature)
+		require.ErrorIs(t, VerifyWalletConnect(&test.DecodeKey(2).PublicKey, msg, sig), ErrWrongSignature)
+
+		salted := append([]byte(nil), sig...)
+		salted[len(salted)-1]++
+		require.ErrorIs(t, VerifyWalletConnect(&key.PublicKey, msg, salted), ErrWrongSignature)
+
+		require.ErrorIs(t, VerifyWalletConnect(&key.PublicKey, msg, sig[:RFC6979SignatureSize]), ErrWrongHashSize)
+		require.ErrorIs(t, VerifyWalletConnect(nil, msg, sig), ErrEmptyPublicKey)
+
+		_, err := SignWalletConnect(nil, msg)
+		require.ErrorIs(t, err, ErrEmptyPrivateKey)
+	})
+}
@@ -3,7 +3,6 @@ package crypto
 import (
 	"bytes"
 	"crypto/ecdsa"
-	"crypto/sha256"
 	"fmt"
 
 	"github.com/mr-tron/base58"
@@ -25,24 +24,21 @@ const (
 	ErrEmptyPrivateKey = internal.Error("empty private key")
 )
 
-func wifCheckSum(data []byte) []byte {
-	sum := sha256.Sum256(data)
-	sum = sha256.Sum256(sum[:])
-
-	return sum[:4]
-}
-
 // WIFEncode encodes the given private key into a WIF string.
 func WIFEncode(key *ecdsa.PrivateKey) (string, error) {
 	if key == nil || key.D == nil {
 		return "", ErrEmptyPrivateKey
+	} else if err := checkPrivateKeyWidth(key.D); err != nil {
+		return "", err
 	}
 
 	data := make([]byte, WIFLength)
+	defer internal.WipeBytes(data)
+
 	data[0] = 0x80
 	data[33] = 0x01
-	copy(data[1:33], key.D.Bytes())
-	copy(data[34:], wifCheckSum(data[:34]))
+	key.D.FillBytes(data[1:33])
+	copy(data[34:], internal.Checksum(data[:34]))
 
 	return base58.Encode(data), nil
 }
@@ -50,11 +46,13 @@ func WIFEncode(key *ecdsa.PrivateKey) (string, error) {
 // WIFDecode decoded the given WIF string into a private key.
 func WIFDecode(wif string) (*ecdsa.PrivateKey, error) {
 	data, err := base58.Decode(wif)
+	defer internal.WipeBytes(data)
+
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrBadWIF, err)
 	} else if actual := len(data); actual != WIFLength {
 		return nil, fmt.Errorf("%w: expect: %d, actual: %d", ErrBadWIF, WIFLength, actual)
-	} else if sum := wifCheckSum(data[:34]); !bytes.Equal(data[34:], sum) {
+	} else if sum := internal.Checksum(data[:34]); !bytes.Equal(data[34:], sum) {
 		return nil, ErrBadChecksum
 	}
 
@@ -0,0 +1,131 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-crypto/internal"
+)
+
+const (
+	// InvocationScriptSize is a size of single-signature invocation script.
+	InvocationScriptSize = 2 + RFC6979SignatureSize
+
+	// ErrBadInvocationScript when invocation script isn't PUSHDATA1 64 <signature>.
+	ErrBadInvocationScript = internal.Error("bad invocation script")
+
+	// ErrBadVerificationScript when verification script isn't a single-signature one.
+	ErrBadVerificationScript = internal.Error("bad verification script")
+)
+
+// Witness is a Neo transaction witness.
+type Witness struct {
+	InvocationScript   []byte
+	VerificationScript []byte
+}
+
+// InvocationScript returns Neo invocation script pushing the signature:
+// PUSHDATA1 64 <signature>. It returns nil if signature has wrong size.
+func InvocationScript(sig []byte) []byte {
+	if len(sig) != RFC6979SignatureSize {
+		return nil
+	}
+
+	script := make([]byte, 0, InvocationScriptSize)
+	script = append(script, opPushData1, RFC6979SignatureSize)
+
+	return append(script, sig...)
+}
+
+// ParseInvocationScript returns signature pushed by single-signature
+// invocation script.
+func ParseInvocationScript(script []byte) ([]byte, error) {
+	if len(script) != InvocationScriptSize || script[0] != opPushData1 || script[1] != RFC6979SignatureSize {
+		return nil, ErrBadInvocationScript
+	}
+
+	return bytes.Clone(script[2:]), nil
+}
+
+// ParseVerificationScript returns public key checked by single-signature
+// verification script (see VerificationScript).
+func ParseVerificationScript(script []byte) (*ecdsa.PublicKey, error) {
+	if len(script) != VerificationScriptSize ||
+		script[0] != opPushData1 || script[1] != PublicKeyCompressedSize ||
+		script[2+PublicKeyCompressedSize] != opSyscall ||
+		!bytes.Equal(script[3+PublicKeyCompressedSize:], checkSigInteropID[:]) {
+		return nil, ErrBadVerificationScript
+	}
+
+	pub := UnmarshalPublicKey(script[2 : 2+PublicKeyCompressedSize])
+	if pub == nil {
+		return nil, fmt.Errorf("%w: bad public key", ErrBadVerificationScript)
+	}
+
+	return pub, nil
+}
+
+// SignedTxHash returns the hash Neo nodes check transaction signatures
+// against: SHA-256 of network magic (4 bytes, little-endian) followed by
+// transaction hash as is (not reversed).
+func SignedTxHash(magic uint32, txHash [sha256.Size]byte) []byte {
+	return hashBytesRFC6979(networkSignedData(magic, txHash))
+}
+
+// networkSignedData returns network magic (4 bytes, little-endian) followed
+// by the hash.
+func networkSignedData(magic uint32, hash [sha256.Size]byte) []byte {
+	data := make([]byte, 4+sha256.Size)
+	binary.LittleEndian.PutUint32(data, magic)
+	copy(data[4:], hash[:])
+
+	return data
+}
+
+// NewWitness signs transaction hash for the given network with the key and
+// returns single-signature witness.
+func NewWitness(key *ecdsa.PrivateKey, magic uint32, txHash [sha256.Size]byte) (*Witness, error) {
+	if key == nil {
+		return nil, ErrEmptyPrivateKey
+	}
+
+	sig, err := SignRFC6979Hash(key, SignedTxHash(magic, txHash))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Witness{
+		InvocationScript:   InvocationScript(sig),
+		VerificationScript: VerificationScript(&key.PublicKey),
+	}, nil
+}
+
+// PublicKey returns public key checked by witness verification script.
+func (w *Witness) PublicKey() (*ecdsa.PublicKey, error) {
+	return ParseVerificationScript(w.VerificationScript)
+}
+
+// ScriptHash returns script hash of witness verification script, i.e.
+// the account the witness is made for.
+func (w *Witness) ScriptHash() [ScriptHashSize]byte {
+	return ScriptHash(w.VerificationScript)
+}
+
+// Verify checks that witness contains valid signature of transaction hash
+// for the given network. It returns nil only if witness is valid.
+func (w *Witness) Verify(magic uint32, txHash [sha256.Size]byte) error {
+	sig, err := ParseInvocationScript(w.InvocationScript)
+	if err != nil {
+		return err
+	}
+
+	pub, err := w.PublicKey()
+	if err != nil {
+		return err
+	}
+
+	return VerifyRFC6979Hash(pub, SignedTxHash(magic, txHash), sig)
+}
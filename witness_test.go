@@ -0,0 +1,89 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-crypto/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWitness(t *testing.T) {
+	const magic = 860833102 // N3 MainNet
+
+	key := test.DecodeKey(1)
+	txHash := sha256.Sum256([]byte("unsigned transaction"))
+
+	w, err := NewWitness(key, magic, txHash)
+	require.NoError(t, err)
+	require.Len(t, w.InvocationScript, InvocationScriptSize)
+	require.Equal(t, VerificationScript(&key.PublicKey), w.VerificationScript)
+	require.Equal(t, PublicKeyScriptHash(&key.PublicKey), w.ScriptHash())
+	require.NoError(t, w.Verify(magic, txHash))
+
+	sig, err := ParseInvocationScript(w.InvocationScript)
+	require.NoError(t, err)
+	require.Equal(t, InvocationScript(sig), w.InvocationScript)
+	require.NoError(t, VerifyRFC6979Hash(&key.PublicKey, SignedTxHash(magic, txHash), sig))
+
+	pub, err := w.PublicKey()
+	require.NoError(t, err)
+	require.Equal(t, MarshalPublicKey(&key.PublicKey), MarshalPublicKey(pub))
+
+	t.Run("signed hash", func(t *testing.T) {
+		data := append([]byte{0x4e, 0x45, 0x4f, 0x33}, txHash[:]...)
+		expected := sha256.Sum256(data)
+		require.Equal(t, expected[:], SignedTxHash(magic, txHash))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		require.ErrorIs(t, w.Verify(magic+1, txHash), ErrWrongSignature)
+		require.ErrorIs(t, w.Verify(magic, sha256.Sum256(nil)), ErrWrongSignature)
+
+		other := &Witness{
+			InvocationScript:   w.InvocationScript,
+			VerificationScript: VerificationScript(&test.DecodeKey(2).PublicKey),
+		}
+		require.ErrorIs(t, other.Verify(magic, txHash), ErrWrongSignature)
+
+		_, err := NewWitness(nil, magic, txHash)
+		require.ErrorIs(t, err, ErrEmptyPrivateKey)
+
+		require.Nil(t, InvocationScript(sig[1:]))
+	})
+
+	t.Run("bad scripts", func(t *testing.T) {
+		for _, script := range [][]byte{
+			nil,
+			w.InvocationScript[1:],
+			append([]byte{0x0d}, w.InvocationScript[1:]...),
+			append([]byte{opPushData1, 63}, w.InvocationScript[2:]...),
+		} {
+			_, err := ParseInvocationScript(script)
+			require.ErrorIs(t, err, ErrBadInvocationScript)
+		}
+
+		badKey := append([]byte(nil), w.VerificationScript...)
+		badKey[2] = 0x05
+
+		badSyscall := append([]byte(nil), w.VerificationScript...)
+		badSyscall[len(badSyscall)-1]++
+
+		for _, script := range [][]byte{
+			nil,
+			w.VerificationScript[1:],
+			w.InvocationScript,
+			badKey,
+			badSyscall,
+		} {
+			_, err := ParseVerificationScript(script)
+			require.ErrorIs(t, err, ErrBadVerificationScript)
+		}
+
+		broken := &Witness{InvocationScript: w.InvocationScript, VerificationScript: badSyscall}
+		require.ErrorIs(t, broken.Verify(magic, txHash), ErrBadVerificationScript)
+
+		broken = &Witness{InvocationScript: w.VerificationScript, VerificationScript: w.VerificationScript}
+		require.ErrorIs(t, broken.Verify(magic, txHash), ErrBadInvocationScript)
+	})
+}